@@ -2961,6 +2961,633 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 	}
 }
 
+var (
+	md_EventConvertEvmToCoin                        protoreflect.MessageDescriptor
+	fd_EventConvertEvmToCoin_sender                 protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_to_bank_addr           protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_bank_coin              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventConvertEvmToCoin = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertEvmToCoin")
+	fd_EventConvertEvmToCoin_sender = md_EventConvertEvmToCoin.Fields().ByName("sender")
+	fd_EventConvertEvmToCoin_erc20_contract_address = md_EventConvertEvmToCoin.Fields().ByName("erc20_contract_address")
+	fd_EventConvertEvmToCoin_to_bank_addr = md_EventConvertEvmToCoin.Fields().ByName("to_bank_addr")
+	fd_EventConvertEvmToCoin_bank_coin = md_EventConvertEvmToCoin.Fields().ByName("bank_coin")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventConvertEvmToCoin)(nil)
+
+type fastReflection_EventConvertEvmToCoin EventConvertEvmToCoin
+
+func (x *EventConvertEvmToCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToCoin)(x)
+}
+
+func (x *EventConvertEvmToCoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventConvertEvmToCoin_messageType fastReflection_EventConvertEvmToCoin_messageType
+var _ protoreflect.MessageType = fastReflection_EventConvertEvmToCoin_messageType{}
+
+type fastReflection_EventConvertEvmToCoin_messageType struct{}
+
+func (x fastReflection_EventConvertEvmToCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToCoin)(nil)
+}
+func (x fastReflection_EventConvertEvmToCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToCoin)
+}
+func (x fastReflection_EventConvertEvmToCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToCoin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventConvertEvmToCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToCoin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventConvertEvmToCoin) Type() protoreflect.MessageType {
+	return _fastReflection_EventConvertEvmToCoin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventConvertEvmToCoin) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToCoin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventConvertEvmToCoin) Interface() protoreflect.ProtoMessage {
+	return (*EventConvertEvmToCoin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventConvertEvmToCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventConvertEvmToCoin_sender, value) {
+			return
+		}
+	}
+	if x.Erc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc20ContractAddress)
+		if !f(fd_EventConvertEvmToCoin_erc20_contract_address, value) {
+			return
+		}
+	}
+	if x.ToBankAddr != "" {
+		value := protoreflect.ValueOfString(x.ToBankAddr)
+		if !f(fd_EventConvertEvmToCoin_to_bank_addr, value) {
+			return
+		}
+	}
+	if x.BankCoin != nil {
+		value := protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+		if !f(fd_EventConvertEvmToCoin_bank_coin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventConvertEvmToCoin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		return x.Erc20ContractAddress != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.to_bank_addr":
+		return x.ToBankAddr != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		return x.BankCoin != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToCoin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		x.Erc20ContractAddress = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.to_bank_addr":
+		x.ToBankAddr = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		x.BankCoin = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventConvertEvmToCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		value := x.Erc20ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToCoin.to_bank_addr":
+		value := x.ToBankAddr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		value := x.BankCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		x.Erc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.to_bank_addr":
+		x.ToBankAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		if x.BankCoin == nil {
+			x.BankCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.to_bank_addr":
+		panic(fmt.Errorf("field to_bank_addr of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventConvertEvmToCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToCoin.to_bank_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventConvertEvmToCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertEvmToCoin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventConvertEvmToCoin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToCoin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventConvertEvmToCoin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToBankAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BankCoin != nil {
+			l = options.Size(x.BankCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BankCoin != nil {
+			encoded, err := options.Marshal(x.BankCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ToBankAddr) > 0 {
+			i -= len(x.ToBankAddr)
+			copy(dAtA[i:], x.ToBankAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToBankAddr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20ContractAddress) > 0 {
+			i -= len(x.Erc20ContractAddress)
+			copy(dAtA[i:], x.Erc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToBankAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BankCoin == nil {
+					x.BankCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_EventTransfer           protoreflect.MessageDescriptor
 	fd_EventTransfer_sender    protoreflect.FieldDescriptor
@@ -2985,7 +3612,7 @@ func (x *EventTransfer) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTransfer) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+	mi := &file_eth_evm_v1_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3531,7 +4158,7 @@ func (x *EventContractDeployed) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventContractDeployed) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+	mi := &file_eth_evm_v1_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4015,7 +4642,7 @@ func (x *EventContractExecuted) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventContractExecuted) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[7]
+	mi := &file_eth_evm_v1_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4777,6 +5404,67 @@ func (x *EventConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
 	return nil
 }
 
+// EventConvertEvmToCoin defines the event of converting ERC20 tokens of a
+// fun token to their bank coin representation.
+type EventConvertEvmToCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender               string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string        `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToBankAddr           string        `protobuf:"bytes,3,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+}
+
+func (x *EventConvertEvmToCoin) Reset() {
+	*x = EventConvertEvmToCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventConvertEvmToCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConvertEvmToCoin) ProtoMessage() {}
+
+// Deprecated: Use EventConvertEvmToCoin.ProtoReflect.Descriptor instead.
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventConvertEvmToCoin) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventConvertEvmToCoin) GetErc20ContractAddress() string {
+	if x != nil {
+		return x.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventConvertEvmToCoin) GetToBankAddr() string {
+	if x != nil {
+		return x.ToBankAddr
+	}
+	return ""
+}
+
+func (x *EventConvertEvmToCoin) GetBankCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.BankCoin
+	}
+	return nil
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	state         protoimpl.MessageState
@@ -4791,7 +5479,7 @@ type EventTransfer struct {
 func (x *EventTransfer) Reset() {
 	*x = EventTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4805,7 +5493,7 @@ func (*EventTransfer) ProtoMessage() {}
 
 // Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{5}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventTransfer) GetSender() string {
@@ -4842,7 +5530,7 @@ type EventContractDeployed struct {
 func (x *EventContractDeployed) Reset() {
 	*x = EventContractDeployed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4856,7 +5544,7 @@ func (*EventContractDeployed) ProtoMessage() {}
 
 // Deprecated: Use EventContractDeployed.ProtoReflect.Descriptor instead.
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{6}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventContractDeployed) GetSender() string {
@@ -4886,7 +5574,7 @@ type EventContractExecuted struct {
 func (x *EventContractExecuted) Reset() {
 	*x = EventContractExecuted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[7]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4900,7 +5588,7 @@ func (*EventContractExecuted) ProtoMessage() {}
 
 // Deprecated: Use EventContractExecuted.ProtoReflect.Descriptor instead.
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{7}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EventContractExecuted) GetSender() string {
@@ -4970,33 +5658,47 @@ var file_eth_evm_v1_events_proto_rawDesc = []byte{
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
 	0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74,
-	0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45,
-	0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0c, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x42, 0x61, 0x6e, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69,
+	0x6e, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42, 0x8a, 0x01, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76,
+	0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68,
+	0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -5011,27 +5713,29 @@ func file_eth_evm_v1_events_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_events_proto_rawDescData
 }
 
-var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_eth_evm_v1_events_proto_goTypes = []interface{}{
 	(*EventEthereumTx)(nil),       // 0: eth.evm.v1.EventEthereumTx
 	(*EventTxLog)(nil),            // 1: eth.evm.v1.EventTxLog
 	(*EventBlockBloom)(nil),       // 2: eth.evm.v1.EventBlockBloom
 	(*EventFunTokenCreated)(nil),  // 3: eth.evm.v1.EventFunTokenCreated
 	(*EventConvertCoinToEvm)(nil), // 4: eth.evm.v1.EventConvertCoinToEvm
-	(*EventTransfer)(nil),         // 5: eth.evm.v1.EventTransfer
-	(*EventContractDeployed)(nil), // 6: eth.evm.v1.EventContractDeployed
-	(*EventContractExecuted)(nil), // 7: eth.evm.v1.EventContractExecuted
-	(*Log)(nil),                   // 8: eth.evm.v1.Log
-	(*v1beta1.Coin)(nil),          // 9: cosmos.base.v1beta1.Coin
+	(*EventConvertEvmToCoin)(nil), // 5: eth.evm.v1.EventConvertEvmToCoin
+	(*EventTransfer)(nil),         // 6: eth.evm.v1.EventTransfer
+	(*EventContractDeployed)(nil), // 7: eth.evm.v1.EventContractDeployed
+	(*EventContractExecuted)(nil), // 8: eth.evm.v1.EventContractExecuted
+	(*Log)(nil),                   // 9: eth.evm.v1.Log
+	(*v1beta1.Coin)(nil),          // 10: cosmos.base.v1beta1.Coin
 }
 var file_eth_evm_v1_events_proto_depIdxs = []int32{
-	8, // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
-	9, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9,  // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
+	10, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	10, // 2: eth.evm.v1.EventConvertEvmToCoin.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_events_proto_init() }
@@ -5102,7 +5806,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTransfer); i {
+			switch v := v.(*EventConvertEvmToCoin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5114,7 +5818,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventContractDeployed); i {
+			switch v := v.(*EventTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5126,6 +5830,18 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractDeployed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventContractExecuted); i {
 			case 0:
 				return &v.state
@@ -5144,7 +5860,7 @@ func file_eth_evm_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
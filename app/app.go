@@ -358,7 +358,7 @@ func NewNibiruApp(
 
 		// ibc
 		ibc.NewAppModule(app.ibcKeeper),
-		ibctransfer.NewAppModule(app.ibcTransferKeeper),
+		ibctransfer.NewAppModule(app.IBCTransferKeeper),
 		ibcfee.NewAppModule(app.ibcFeeKeeper),
 		ica.NewAppModule(&app.icaControllerKeeper, &app.icaHostKeeper),
 		ibcwasm.NewAppModule(app.WasmClientKeeper),
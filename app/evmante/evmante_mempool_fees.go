@@ -39,8 +39,9 @@ func (d MempoolGasPriceDecorator) AnteHandle(
 	}
 
 	minGasPrice := ctx.MinGasPrices().AmountOf(evm.EVMBankDenom)
-	baseFeeMicronibi := d.evmKeeper.BaseFeeMicronibiPerGas(ctx)
-	baseFeeMicronibiDec := sdkmath.LegacyNewDecFromBigInt(baseFeeMicronibi)
+	baseFeeWei := d.evmKeeper.BaseFeeWeiPerGas(ctx)
+	// The base fee in wei is exact in micronibi with 12 decimal places.
+	baseFeeMicronibiDec := sdkmath.LegacyNewDecFromBigIntWithPrec(baseFeeWei, 12)
 
 	// if MinGasPrices is not set, skip the check
 	if minGasPrice.IsZero() {
@@ -59,9 +60,8 @@ func (d MempoolGasPriceDecorator) AnteHandle(
 			)
 		}
 
-		baseFeeWei := evm.NativeToWei(baseFeeMicronibi)
-		effectiveGasPriceDec := sdkmath.LegacyNewDecFromBigInt(
-			evm.WeiToNative(ethTx.EffectiveGasPriceWeiPerGas(baseFeeWei)),
+		effectiveGasPriceDec := sdkmath.LegacyNewDecFromBigIntWithPrec(
+			ethTx.EffectiveGasPriceWeiPerGas(baseFeeWei), 12,
 		)
 		if effectiveGasPriceDec.LT(minGasPrice) {
 			// if sdk.NewDecFromBigInt(effectiveGasPrice).LT(minGasPrice) {
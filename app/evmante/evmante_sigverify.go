@@ -34,7 +34,7 @@ func (esvd EthSigVerificationDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (newCtx sdk.Context, err error) {
 	chainID := esvd.evmKeeper.EthChainID(ctx)
-	ethCfg := evm.EthereumConfig(chainID)
+	ethCfg := esvd.evmKeeper.GetParams(ctx).EthereumConfig(chainID)
 	blockNum := big.NewInt(ctx.BlockHeight())
 	signer := gethcore.MakeSigner(
 		ethCfg,
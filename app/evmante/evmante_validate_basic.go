@@ -3,6 +3,7 @@ package evmante
 
 import (
 	"errors"
+	"math/big"
 
 	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -89,7 +90,8 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	txFee := sdk.Coins{}
 	txGasLimit := uint64(0)
 
-	baseFeeMicronibi := vbd.evmKeeper.BaseFeeMicronibiPerGas(ctx)
+	baseFeeWei := vbd.evmKeeper.BaseFeeWeiPerGas(ctx)
+	var feeCapBelowBaseFee *big.Int
 
 	for _, msg := range protoTx.GetMsgs() {
 		msgEthTx, ok := msg.(*evm.MsgEthereumTx)
@@ -115,15 +117,20 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, sdkioerrors.Wrap(err, "failed to unpack MsgEthereumTx Data")
 		}
 
-		if baseFeeMicronibi == nil && txData.TxType() == gethcore.DynamicFeeTxType {
+		if baseFeeWei == nil && txData.TxType() == gethcore.DynamicFeeTxType {
 			return ctx, sdkioerrors.Wrap(
 				gethcore.ErrTxTypeNotSupported,
 				"dynamic fee tx not supported",
 			)
 		}
 
-		// Compute fees using effective fee to enforce 1unibi minimum gas price
-		effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(evm.BASE_FEE_WEI))
+		if baseFeeWei != nil && feeCapBelowBaseFee == nil &&
+			txData.GetGasFeeCapWei().Cmp(baseFeeWei) < 0 {
+			feeCapBelowBaseFee = txData.GetGasFeeCapWei()
+		}
+
+		// Compute fees using effective fee at the current base fee
+		effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(baseFeeWei))
 		txFee = txFee.Add(
 			sdk.Coin{
 				Denom:  evm.EVMBankDenom,
@@ -132,6 +139,17 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		)
 	}
 
+	// A gas fee cap below the base fee is raised to the base fee, which the
+	// declared fee must cover. Otherwise, the fee was computed at a lower base
+	// fee, like the one of [evm.MsgEthereumTx.BuildTx].
+	if feeCapBelowBaseFee != nil && !authInfo.Fee.Amount.IsAllGTE(txFee) {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"gas fee cap (wei) less than block base fee (wei); (%s < %s)",
+			feeCapBelowBaseFee, baseFeeWei,
+		)
+	}
+
 	if !authInfo.Fee.Amount.IsEqual(txFee) {
 		return ctx, sdkioerrors.Wrapf(
 			sdkerrors.ErrInvalidRequest,
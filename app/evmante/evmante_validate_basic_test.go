@@ -141,7 +141,7 @@ func (s *TestSuite) TestEthValidateBasicDecorator() {
 				chainID := deps.App.EvmKeeper.EthChainID(deps.Ctx)
 				gasLimit := uint64(10)
 				fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", int64(gasLimit)))
-				msg := buildEthMsg(chainID, gasLimit, deps.Sender.NibiruAddr.String(), nil)
+				msg := buildEthMsg(chainID, gasLimit, deps.Sender.NibiruAddr.String(), nil, evm.BASE_FEE_WEI)
 				return buildTx(deps, false, msg, gasLimit, fees)
 			},
 			wantErr: "for eth tx length of ExtensionOptions should be 1",
@@ -166,7 +166,7 @@ func (s *TestSuite) TestEthValidateBasicDecorator() {
 				chainID := deps.App.EvmKeeper.EthChainID(deps.Ctx)
 				gasLimit := uint64(10)
 				fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", int64(gasLimit)))
-				msg := buildEthMsg(chainID, gasLimit, deps.Sender.NibiruAddr.String(), nil)
+				msg := buildEthMsg(chainID, gasLimit, deps.Sender.NibiruAddr.String(), nil, evm.BASE_FEE_WEI)
 				return buildTx(deps, true, msg, gasLimit, fees)
 			},
 			wantErr: "invalid From",
@@ -177,7 +177,7 @@ func (s *TestSuite) TestEthValidateBasicDecorator() {
 				chainID := deps.App.EvmKeeper.EthChainID(deps.Ctx)
 				gasLimit := uint64(10)
 				fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", 5))
-				msg := buildEthMsg(chainID, gasLimit, "", nil)
+				msg := buildEthMsg(chainID, gasLimit, "", nil, evm.BASE_FEE_WEI)
 				return buildTx(deps, true, msg, gasLimit, fees)
 			},
 			wantErr: "invalid AuthInfo Fee Amount",
@@ -188,11 +188,21 @@ func (s *TestSuite) TestEthValidateBasicDecorator() {
 				chainID := deps.App.EvmKeeper.EthChainID(deps.Ctx)
 				gasLimit := uint64(10)
 				fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", int64(gasLimit)))
-				msg := buildEthMsg(chainID, gasLimit, "", nil)
+				msg := buildEthMsg(chainID, gasLimit, "", nil, evm.BASE_FEE_WEI)
 				return buildTx(deps, true, msg, 5, fees)
 			},
 			wantErr: "invalid AuthInfo Fee GasLimit",
 		},
+		{
+			name: "sad: gas price below base fee",
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				chainID := deps.App.EvmKeeper.EthChainID(deps.Ctx)
+				gasLimit := uint64(10)
+				msg := buildEthMsg(chainID, gasLimit, "", nil, big.NewInt(1))
+				return buildTx(deps, true, msg, gasLimit, sdk.NewCoins())
+			},
+			wantErr: "insufficient fee",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -231,13 +241,14 @@ func buildEthMsg(
 	gasLimit uint64,
 	from string,
 	to *common.Address,
+	gasPrice *big.Int,
 ) *evm.MsgEthereumTx {
 	ethContractCreationTxParams := &evm.EvmTxArgs{
 		ChainID:  chainID,
 		Nonce:    1,
 		Amount:   big.NewInt(10),
 		GasLimit: gasLimit,
-		GasPrice: big.NewInt(1),
+		GasPrice: gasPrice,
 		To:       to,
 	}
 	tx := evm.NewTx(ethContractCreationTxParams)
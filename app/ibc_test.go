@@ -2,16 +2,23 @@ package app_test
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
 // init changes the value of 'DefaultTestingAppInit' to use custom initialization.
@@ -191,3 +198,104 @@ func (suite *IBCTestSuite) TestHandleMsgTransfer() {
 	balance = chainCApp.BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
 	suite.Require().Zero(balance.Amount.Int64())
 }
+
+// TestICS20PrecompileRefundAsERC20 sends FunToken ERC20s over IBC with the
+// ICS20 precompile to an invalid receiver, and checks that the refund of the
+// failed transfer returns to the EVM sender as ERC20 tokens.
+func (suite *IBCTestSuite) TestICS20PrecompileRefundAsERC20() {
+	path := NewIBCTestingTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainAApp, ok := suite.chainA.App.(*app.NibiruApp)
+	suite.Require().True(ok)
+	// The precompile registry of geth is global, so it holds the keepers of
+	// the app constructed last. Point it at the keepers of chainA.
+	precompile.InitPrecompiles(chainAApp.AppKeepers.PublicKeepers)
+	deps := evmtest.TestDeps{
+		App:       chainAApp,
+		Ctx:       suite.chainA.GetContext(),
+		EvmKeeper: chainAApp.EvmKeeper,
+		GenState:  evm.DefaultGenesisState(),
+		Sender:    evmtest.NewEthPrivAcc(),
+	}
+	bankDenom := "ufoo"
+	funtoken := evmtest.CreateFunTokenForBankCoin(deps, bankDenom, &suite.Suite)
+	erc20 := funtoken.Erc20Addr.Address
+
+	suite.T().Log("Give the sender 1000 tokens as ERC20")
+	suite.Require().NoError(testapp.FundAccount(
+		chainAApp.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 1000)),
+	))
+	_, err := chainAApp.EvmKeeper.ConvertCoinToEvm(deps.GoCtx(), &evm.MsgConvertCoinToEvm{
+		Sender:    deps.Sender.NibiruAddr.String(),
+		BankCoin:  sdk.NewInt64Coin(bankDenom, 1000),
+		ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+	})
+	suite.Require().NoError(err)
+
+	suite.T().Log("IICS20.transfer of 400 ERC20 tokens to an invalid receiver")
+	timeoutHeight := suite.chainB.GetTimeoutHeight()
+	receiver := "invalid-receiver"
+	input, err := embeds.SmartContract_ICS20.ABI.Pack(
+		string(precompile.ICS20Method_transfer),
+		path.EndpointA.ChannelID,
+		path.EndpointA.ChannelConfig.PortID,
+		erc20.Hex(),
+		big.NewInt(400),
+		receiver,
+		struct {
+			RevisionNumber uint64 `json:"revisionNumber"`
+			RevisionHeight uint64 `json:"revisionHeight"`
+		}{timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight},
+		uint64(0),
+		"",
+	)
+	suite.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	evmResp, err := chainAApp.EvmKeeper.CallContractWithInput(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_ICS20,
+		true /*commit*/, input, evmtest.FunTokenGasLimitSendToEvm,
+	)
+	suite.Require().NoError(err)
+	var sequence uint64
+	suite.Require().NoError(embeds.SmartContract_ICS20.ABI.UnpackIntoInterface(
+		&sequence, string(precompile.ICS20Method_transfer), evmResp.Ret,
+	))
+
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		suite.T(), deps, evmObj, erc20, deps.Sender.EthAddr, big.NewInt(600),
+		"expect the transferred ERC20 tokens to leave the sender",
+	)
+	escrowAddr := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(
+		int64(400), chainAApp.BankKeeper.GetBalance(deps.Ctx, escrowAddr, bankDenom).Amount.Int64(),
+	)
+	suite.coordinator.CommitBlock(suite.chainA)
+	// "deps.NewEVM" binds a StateDB of the previous block to the bank keeper.
+	// Drop it so that the relay doesn't write balances through it.
+	chainAApp.EvmKeeper.Bank.StateDB = nil
+
+	suite.T().Log("Relay the packet. The receiver is invalid, so chainB acknowledges an error.")
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		bankDenom, "400", deps.Sender.NibiruAddr.String(), receiver, "",
+	)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), sequence,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		timeoutHeight, 0,
+	)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	deps.Ctx = suite.chainA.GetContext()
+	evmObj, _ = deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		suite.T(), deps, evmObj, erc20, deps.Sender.EthAddr, big.NewInt(1000),
+		"expect the refund to return as ERC20",
+	)
+	evmtest.AssertBankBalanceEqualWithDescription(
+		suite.T(), deps, bankDenom, deps.Sender.EthAddr, big.NewInt(0),
+		"expect no refund as bank coins",
+	)
+}
@@ -57,9 +57,11 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app/keepers"
 	"github.com/NibiruChain/nibiru/v2/app/wasmext"
+	devgasante "github.com/NibiruChain/nibiru/v2/x/devgas/v1/ante"
 	devgaskeeper "github.com/NibiruChain/nibiru/v2/x/devgas/v1/keeper"
 	devgastypes "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
 	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmmodule"
 	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
@@ -85,10 +87,8 @@ type AppKeepers struct {
 
 	/* ibcKeeper defines each ICS keeper for IBC. ibcKeeper must be a pointer in
 	   the app, so we can SetRouter on it correctly. */
-	ibcKeeper    *ibckeeper.Keeper
-	ibcFeeKeeper ibcfeekeeper.Keeper
-	/* ibcTransferKeeper is for cross-chain fungible token transfers. */
-	ibcTransferKeeper   ibctransferkeeper.Keeper
+	ibcKeeper           *ibckeeper.Keeper
+	ibcFeeKeeper        ibcfeekeeper.Keeper
 	icaControllerKeeper icacontrollerkeeper.Keeper
 	icaHostKeeper       icahostkeeper.Keeper
 }
@@ -162,7 +162,7 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		app.BankKeeper,
 	)
 
-	app.ibcTransferKeeper = ibctransferkeeper.NewKeeper(
+	app.IBCTransferKeeper = ibctransferkeeper.NewKeeper(
 		app.appCodec,
 		app.keys[ibctransfertypes.StoreKey],
 		/* paramSubspace */ app.getSubspace(ibctransfertypes.ModuleName),
@@ -225,7 +225,8 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		CapabilityKeeper: app.ScopedWasmKeeper,
 		BankKeeper:       app.BankKeeper,
 		Unpacker:         app.appCodec,
-		PortSource:       app.ibcTransferKeeper,
+		PortSource:       app.IBCTransferKeeper,
+		EvmKeeper:        app.EvmKeeper,
 	}
 	app.WasmMsgHandlerArgs = wmha
 	app.WasmKeeper = wasmkeeper.NewKeeper(
@@ -258,16 +259,20 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		app.GRPCQueryRouter(),
 	)
 
-	// DevGas uses WasmKeeper
+	// DevGas uses WasmKeeper and EvmKeeper
 	app.DevGasKeeper = devgaskeeper.NewKeeper(
 		app.keys[devgastypes.StoreKey],
 		app.appCodec,
 		app.BankKeeper,
 		app.WasmKeeper,
+		app.EvmKeeper,
 		app.AccountKeeper,
 		authtypes.FeeCollectorName,
 		govModuleAddr,
 	)
+	app.EvmKeeper.SetDevGasPayout(
+		devgasante.NewDevGasPayoutDecorator(app.BankKeeper, &app.DevGasKeeper),
+	)
 
 	// register the proposal types
 
@@ -285,13 +290,15 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 
 	// transfer stack contains (from top to bottom):
 	// - IBC Fee Middleware
+	// - EVM Transfer Middleware (ERC20 refunds of the ICS20 precompile)
 	// - Transfer
 
 	ibcRouter := porttypes.NewRouter()
 
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
-	transferStack = ibctransfer.NewIBCModule(app.ibcTransferKeeper)
+	transferStack = ibctransfer.NewIBCModule(app.IBCTransferKeeper)
+	transferStack = evmmodule.NewIBCTransferMiddleware(transferStack, app.EvmKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.ibcFeeKeeper)
 
 	// Create Interchain Accounts Stack
@@ -2,18 +2,30 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmnode "github.com/cometbft/cometbft/node"
 	sm "github.com/cometbft/cometbft/state"
 	tmstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	flagCursor = "cursor"
+	flagLimit  = "limit"
 )
 
 func NewEVMTxIndexCmd() *cobra.Command {
@@ -30,104 +42,484 @@ Processes blocks from minBlockNumber to maxBlockNumber, indexes evm txs.
 Default run before the full node/archive node start should be:
 
 nibid evm-tx-index last-indexed latest
+
+The blocks are also written to the external sink set by the
+"json-rpc.indexer-sink" config, so that an earliest-to-latest run backfills a
+new Postgres or SQLite sink.
+
+The subcommands maintain the EVMIndexerDB: "prune" caps its disk use,
+"verify" checks it for missing or partially indexed blocks and "repair"
+re-indexes them.
 		`,
 		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEVMTxIndex(cmd, args[0], args[1])
+		},
+	}
+	cmd.AddCommand(
+		newEVMTxIndexPruneCmd(),
+		newEVMTxIndexVerifyCmd(),
+		newEVMTxIndexRepairCmd(),
+	)
+	return cmd
+}
+
+func newEVMTxIndexPruneCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune [retainBlocks]",
+		Short: "Prune the evm blocks older than a retention window from the EVMIndexerDB",
+		Long: `Deletes the evm txs, address index entries and logs of the blocks older than
+the last retainBlocks blocks of the node. retainBlocks defaults to the
+"json-rpc.indexer-retain-blocks" config, which also makes a running node prune
+in the background.
+		`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			retainBlocks := serverCtx.Viper.GetInt64(JSONRPCIndexerRetainBlocks)
+			if len(args) == 1 {
+				var err error
+				retainBlocks, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("cannot parse retain blocks: %s", args[0])
+				}
+			}
+			if retainBlocks <= 0 {
+				return fmt.Errorf("retain blocks must be positive, got %d", retainBlocks)
+			}
+
+			stores, err := openEVMTxIndexStores(cmd, false)
 			if err != nil {
 				return err
 			}
-			cfg := serverCtx.Config
-			logger := serverCtx.Logger
-			evmIndexerDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			defer stores.close()
+
+			pruneHeight := stores.blockStore.Height() - retainBlocks + 1
+			if pruneHeight <= 1 {
+				fmt.Printf("Nothing to prune, the node has fewer than %d blocks\n", retainBlocks)
+				return nil
+			}
+			if err := stores.evmTxIndexer.PruneBlocks(pruneHeight); err != nil {
+				return err
+			}
+			fmt.Printf("Pruned blocks below %d\n", pruneHeight)
+			return nil
+		},
+	}
+}
+
+func newEVMTxIndexVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify",
+		Short: "Verify that the EVMIndexerDB has every evm tx of the indexed blocks",
+		Long: `Scans the blocks from the first to the last block of the EVMIndexerDB and
+compares the number of indexed evm txs of each block with its block results.
+It reports the blocks that are missing from the EVMIndexerDB, for instance
+after a crash, and the blocks with mismatched tx counts. The command fails if
+it finds any, which "nibid evm-tx-index repair" fixes.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			stores, err := openEVMTxIndexStores(cmd, false)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
+			defer stores.close()
 
-			evmTxIndexer := indexer.NewEVMTxIndexer(evmIndexerDB, logger.With("module", "evmindex"), clientCtx)
+			badBlocks, err := stores.verify()
+			if err != nil {
+				return err
+			}
+			if len(badBlocks) > 0 {
+				return fmt.Errorf(
+					"found %d missing or mismatched blocks, run \"nibid evm-tx-index repair\" to re-index them",
+					len(badBlocks))
+			}
+			fmt.Println("Verification complete, no missing or mismatched blocks")
+			return nil
+		},
+	}
+}
 
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+func newEVMTxIndexRepairCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "repair",
+		Short: "Re-index the missing and mismatched blocks of the EVMIndexerDB",
+		Long: `Runs the scan of "nibid evm-tx-index verify" and re-indexes only the blocks
+that are missing from the EVMIndexerDB or have mismatched tx counts.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			stores, err := openEVMTxIndexStores(cmd, true)
 			if err != nil {
 				return err
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
-			minAvailableHeight := blockStore.Base()
-			maxAvailableHeight := blockStore.Height() - 1 // exclude last block as block info could be uncommitted
-			fmt.Printf("Block range available on the node: %d - %d\n", minAvailableHeight, maxAvailableHeight)
-
-			var fromBlock int64
-			var toBlock int64
-
-			// FROM block could be one of two:
-			// - int64 number - replaced with minAvailableHeight if too low
-			// - last-indexed - latest available block in EVMIndexerDB, 0 if nothing is indexed
-			if args[0] == "last-indexed" {
-				fromBlock, err = evmTxIndexer.LastIndexedBlock()
-				if err != nil || fromBlock < 0 {
-					fromBlock = 0
-				}
-			} else {
-				fromBlock, err = strconv.ParseInt(args[1], 10, 64)
+			defer stores.close()
+
+			badBlocks, err := stores.verify()
+			if err != nil {
+				return err
+			}
+			for _, height := range badBlocks {
+				block, blockResults, err := stores.loadBlock(height)
 				if err != nil {
-					return fmt.Errorf("cannot parse min block number: %s", args[1])
+					return err
 				}
-				if fromBlock > maxAvailableHeight {
-					return fmt.Errorf("maximum available block is: %d", maxAvailableHeight)
+				if err := stores.evmTxIndexer.ReindexBlock(block, blockResults); err != nil {
+					return err
 				}
+				fmt.Printf("Re-indexed block %d\n", height)
 			}
-			if fromBlock < minAvailableHeight {
-				fromBlock = minAvailableHeight
-			}
+			fmt.Printf("Repair complete, re-indexed %d blocks\n", len(badBlocks))
+			return nil
+		},
+	}
+}
 
-			// TO block could be one of two:
-			// - int64 number - replaced with maxAvailableHeight if too high
-			// - latest - latest available block in the node
-			if args[1] == "latest" {
-				toBlock = maxAvailableHeight
-			} else {
-				toBlock, err = strconv.ParseInt(args[1], 10, 64)
+func NewEVMTxReindexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-tx-reindex [minBlockNumber|earliest] [maxBlockNumber|latest]",
+		Short: "Re-index historical evm blocks, transactions and logs",
+		Long: `Command is useful for backfilling the EVM log index, which serves
+eth_getLogs, and the address indexes, which serve
+nibiru_getTransactionsByAddress, for blocks that were indexed before they existed.
+Processes blocks from minBlockNumber to maxBlockNumber, indexes evm txs, their
+senders and recipients, and their logs, whether or not the blocks are already
+indexed.
+
+- minBlockNumber: min block to start indexing. Supply "earliest" to start with the first block available on the node.
+- maxBlockNumber: max block, could be a number or "latest".
+
+The log index only serves eth_getLogs for a contiguous range of blocks, so a
+backfill should reach the blocks that are already indexed:
+
+nibid evm-tx-reindex earliest latest
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEVMTxIndex(cmd, args[0], args[1])
+		},
+	}
+	return cmd
+}
+
+// runEVMTxIndex indexes the blocks from "minArg" to "maxArg" in the
+// EVMIndexerDB. Besides a block number, "minArg" accepts "last-indexed" or
+// "earliest" and "maxArg" accepts "latest".
+func runEVMTxIndex(cmd *cobra.Command, minArg, maxArg string) error {
+	stores, err := openEVMTxIndexStores(cmd, true)
+	if err != nil {
+		return err
+	}
+	evmTxIndexer := stores.evmTxIndexer
+	minAvailableHeight := stores.blockStore.Base()
+	maxAvailableHeight := stores.blockStore.Height() - 1 // exclude last block as block info could be uncommitted
+	fmt.Printf("Block range available on the node: %d - %d\n", minAvailableHeight, maxAvailableHeight)
+
+	var fromBlock int64
+	var toBlock int64
+
+	// FROM block could be one of three:
+	// - int64 number - replaced with minAvailableHeight if too low
+	// - last-indexed - latest available block in EVMIndexerDB, 0 if nothing is indexed
+	// - earliest - minAvailableHeight
+	switch minArg {
+	case "last-indexed":
+		fromBlock, err = evmTxIndexer.LastIndexedBlock()
+		if err != nil || fromBlock < 0 {
+			fromBlock = 0
+		}
+	case "earliest":
+		fromBlock = minAvailableHeight
+	default:
+		fromBlock, err = strconv.ParseInt(minArg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("cannot parse min block number: %s", minArg)
+		}
+		if fromBlock > maxAvailableHeight {
+			return fmt.Errorf("maximum available block is: %d", maxAvailableHeight)
+		}
+	}
+	if fromBlock < minAvailableHeight {
+		fromBlock = minAvailableHeight
+	}
+
+	// TO block could be one of two:
+	// - int64 number - replaced with maxAvailableHeight if too high
+	// - latest - latest available block in the node
+	if maxArg == "latest" {
+		toBlock = maxAvailableHeight
+	} else {
+		toBlock, err = strconv.ParseInt(maxArg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("cannot parse max block number: %s", maxArg)
+		}
+		if toBlock > maxAvailableHeight {
+			toBlock = maxAvailableHeight
+		}
+	}
+	if fromBlock > toBlock {
+		return fmt.Errorf("minBlockNumber must be less or equal to maxBlockNumber")
+	}
+
+	fmt.Printf("Indexing blocks from %d to %d\n", fromBlock, toBlock)
+	for height := fromBlock; height <= toBlock; height++ {
+		block, blockResults, err := stores.loadBlock(height)
+		if err != nil {
+			return err
+		}
+		if err := evmTxIndexer.IndexBlock(block, blockResults); err != nil {
+			return err
+		}
+		fmt.Println(height)
+	}
+	err = evmTxIndexer.CloseDBAndExit()
+	if err != nil {
+		return err
+	}
+	fmt.Println("Indexing complete")
+	return nil
+}
+
+func NewEVMTxHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-tx-history [address] [fromBlock|earliest] [toBlock|latest]",
+		Short: "List the evm txs sent by or to an address from the EVMIndexerDB",
+		Long: `Lists a page of the evm txs sent by or to an address, in chain order,
+from the address indexes of the EVMIndexerDB. Recipients include the contracts
+created by the txs and the recipients of FunToken precompile transfers. This is
+the offline equivalent of the "nibiru_getTransactionsByAddress" JSON-RPC method.
+
+- address: Ethereum hex or nibi-prefixed Bech32 address.
+- fromBlock: min block, could be a number or "earliest".
+- toBlock: max block, could be a number or "latest".
+
+The output includes the cursor of the next page, which is passed back with
+--cursor to get the following page:
+
+nibid evm-tx-history 0x... earliest latest --limit 50
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := parseHexOrBech32Addr(args[0])
+			if err != nil {
+				return err
+			}
+			cursorArg, err := cmd.Flags().GetString(flagCursor)
+			if err != nil {
+				return err
+			}
+			var cursor []byte
+			if cursorArg != "" {
+				cursor, err = hexutil.Decode(cursorArg)
 				if err != nil {
-					return fmt.Errorf("cannot parse max block number: %s", args[1])
-				}
-				if toBlock > maxAvailableHeight {
-					toBlock = maxAvailableHeight
+					return fmt.Errorf("cannot parse cursor: %s", cursorArg)
 				}
 			}
-			if fromBlock > toBlock {
-				return fmt.Errorf("minBlockNumber must be less or equal to maxBlockNumber")
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
 			}
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+			evmIndexerDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
+			defer evmIndexerDB.Close()
+			evmTxIndexer := indexer.NewEVMTxIndexer(
+				evmIndexerDB, serverCtx.Logger.With("module", "evmindex"), clientCtx,
+			)
 
-			fmt.Printf("Indexing blocks from %d to %d\n", fromBlock, toBlock)
-			for height := fromBlock; height <= toBlock; height++ {
-				block := blockStore.LoadBlock(height)
-				if block == nil {
-					return fmt.Errorf("block not found %d", height)
+			var fromBlock, toBlock int64
+			if args[1] != "earliest" {
+				fromBlock, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("cannot parse from block number: %s", args[1])
 				}
-				blockResults, err := stateStore.LoadABCIResponses(height)
+			}
+			if args[2] == "latest" {
+				toBlock, err = evmTxIndexer.LastIndexedBlock()
 				if err != nil {
 					return err
 				}
-				if err := evmTxIndexer.IndexBlock(block, blockResults.DeliverTxs); err != nil {
+			} else {
+				toBlock, err = strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("cannot parse to block number: %s", args[2])
+				}
+			}
+
+			txHashes, nextCursor, err := evmTxIndexer.GetTxsByAddress(
+				address, fromBlock, toBlock, cursor, limit,
+			)
+			if err != nil {
+				return err
+			}
+
+			type txEntry struct {
+				Hash       gethcommon.Hash `json:"hash"`
+				Height     int64           `json:"height"`
+				EthTxIndex int32           `json:"eth_tx_index"`
+			}
+			out := struct {
+				Txs        []txEntry      `json:"txs"`
+				NextCursor *hexutil.Bytes `json:"next_cursor"`
+			}{Txs: []txEntry{}}
+			for _, txHash := range txHashes {
+				txResult, err := evmTxIndexer.GetByTxHash(txHash)
+				if err != nil {
 					return err
 				}
-				fmt.Println(height)
+				out.Txs = append(out.Txs, txEntry{
+					Hash:       txHash,
+					Height:     txResult.Height,
+					EthTxIndex: txResult.EthTxIndex,
+				})
+			}
+			if nextCursor != nil {
+				next := hexutil.Bytes(nextCursor)
+				out.NextCursor = &next
 			}
-			err = evmTxIndexer.CloseDBAndExit()
+			bz, err := json.MarshalIndent(out, "", "  ")
 			if err != nil {
 				return err
 			}
-			fmt.Println("Indexing complete")
+			fmt.Println(string(bz))
 			return nil
 		},
 	}
+	cmd.Flags().String(flagCursor, "", "cursor of the page, as returned in \"next_cursor\" by the previous page")
+	cmd.Flags().Int(flagLimit, 100, "maximum number of txs in the page")
 	return cmd
 }
+
+// parseHexOrBech32Addr parses an Ethereum hex or a nibi-prefixed Bech32
+// address.
+func parseHexOrBech32Addr(addr string) (gethcommon.Address, error) {
+	if err := eth.ValidateAddress(addr); err == nil {
+		return gethcommon.HexToAddress(addr), nil
+	}
+	nibiAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("invalid hex or bech32 address %s: %w", addr, err)
+	}
+	return eth.NibiruAddrToEthAddr(nibiAddr), nil
+}
+
+// evmTxIndexStores holds the EVMIndexerDB and the CometBFT block and state
+// stores, opened by the evm-tx-index commands while the node is stopped.
+type evmTxIndexStores struct {
+	evmTxIndexer *indexer.EVMTxIndexer
+	blockStore   *tmstore.BlockStore
+	stateStore   sm.Store
+}
+
+// openEVMTxIndexStores opens the stores of the evm-tx-index commands. With
+// "withSinks", the indexer also writes the blocks it indexes to the external
+// sink set by the "json-rpc.indexer-sink" config, which backfills the sink.
+func openEVMTxIndexStores(cmd *cobra.Command, withSinks bool) (*evmTxIndexStores, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg := serverCtx.Config
+	logger := serverCtx.Logger
+	evmIndexerDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+	var sinks []indexer.IndexerSink
+	if withSinks {
+		sinks, err = OpenIndexerSinks(serverCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	blockStoreDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	return &evmTxIndexStores{
+		evmTxIndexer: indexer.NewEVMTxIndexer(evmIndexerDB, logger.With("module", "evmindex"), clientCtx, sinks...),
+		blockStore:   tmstore.NewBlockStore(blockStoreDB),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		}),
+	}, nil
+}
+
+// loadBlock loads a block and the results of its txs.
+func (s *evmTxIndexStores) loadBlock(height int64) (*cmttypes.Block, []*abci.ResponseDeliverTx, error) {
+	block := s.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	blockResults, err := s.stateStore.LoadABCIResponses(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return block, blockResults.DeliverTxs, nil
+}
+
+// verify scans the blocks from the first to the last block of the
+// EVMIndexerDB that are available on the node, prints the blocks that are
+// missing or have mismatched tx counts and returns their heights.
+func (s *evmTxIndexStores) verify() (badBlocks []int64, err error) {
+	firstIndexed, err := s.evmTxIndexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	lastIndexed, err := s.evmTxIndexer.LastIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	if firstIndexed < 0 {
+		fmt.Println("EVMIndexerDB is empty")
+		return nil, nil
+	}
+	fromBlock := max(firstIndexed, s.blockStore.Base())
+	// exclude last block as block info could be uncommitted
+	toBlock := min(lastIndexed, s.blockStore.Height()-1)
+	if fromBlock > firstIndexed {
+		fmt.Printf("Blocks %d - %d are pruned from the node and cannot be verified\n", firstIndexed, fromBlock-1)
+	}
+
+	fmt.Printf("Verifying blocks from %d to %d\n", fromBlock, toBlock)
+	for height := fromBlock; height <= toBlock; height++ {
+		block, blockResults, err := s.loadBlock(height)
+		if err != nil {
+			return nil, err
+		}
+		indexedTxs, expectedTxs, err := s.evmTxIndexer.VerifyBlock(block, blockResults)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case indexedTxs == expectedTxs:
+			continue
+		case indexedTxs == 0:
+			fmt.Printf("Block %d: missing, expected %d evm txs\n", height, expectedTxs)
+		default:
+			fmt.Printf("Block %d: mismatch, indexed %d evm txs, expected %d\n", height, indexedTxs, expectedTxs)
+		}
+		badBlocks = append(badBlocks, height)
+	}
+	return badBlocks, nil
+}
+
+func (s *evmTxIndexStores) close() {
+	if err := s.evmTxIndexer.CloseDBAndExit(); err != nil {
+		fmt.Printf("failed to close evm indexer DB: %s\n", err)
+	}
+}
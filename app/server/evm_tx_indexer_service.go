@@ -17,6 +17,14 @@ const (
 	EVMTxIndexerServiceName = "EVMTxIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// IndexBlockRetryInterval is the time waited before indexing a block again
+	// after it failed, for example because an external sink is unavailable.
+	IndexBlockRetryInterval = 5 * time.Second
+
+	// IndexerPruneInterval is the number of blocks indexed between two prunings
+	// of the blocks outside of the retention window.
+	IndexerPruneInterval = 100
 )
 
 // EVMTxIndexerService indexes transactions for json-rpc service.
@@ -26,11 +34,22 @@ type EVMTxIndexerService struct {
 	evmTxIndexer *indexer.EVMTxIndexer
 	rpcClient    cmtrpcclient.Client
 	cancelFunc   context.CancelFunc
+	// retainBlocks is the number of recent blocks kept in the index, or zero to
+	// keep all blocks.
+	retainBlocks int64
 }
 
-// NewEVMIndexerService returns a new service instance.
-func NewEVMIndexerService(evmTxIndexer *indexer.EVMTxIndexer, rpcClient cmtrpcclient.Client) *EVMTxIndexerService {
-	indexerService := &EVMTxIndexerService{evmTxIndexer: evmTxIndexer, rpcClient: rpcClient}
+// NewEVMIndexerService returns a new service instance. If "retainBlocks" is
+// positive, the service prunes the blocks older than the last "retainBlocks"
+// blocks every [IndexerPruneInterval] blocks.
+func NewEVMIndexerService(
+	evmTxIndexer *indexer.EVMTxIndexer, rpcClient cmtrpcclient.Client, retainBlocks int64,
+) *EVMTxIndexerService {
+	indexerService := &EVMTxIndexerService{
+		evmTxIndexer: evmTxIndexer,
+		rpcClient:    rpcClient,
+		retainBlocks: retainBlocks,
+	}
 	indexerService.BaseService = *service.NewBaseService(nil, EVMTxIndexerServiceName, indexerService)
 	return indexerService
 }
@@ -95,6 +114,8 @@ func (service *EVMTxIndexerService) OnStart() error {
 	if lastIndexedHeight == -1 {
 		lastIndexedHeight = atomic.LoadInt64(&chainHeightStorage)
 	}
+	// Prune on the first iteration, then every IndexerPruneInterval blocks
+	lastPrunedHeight := int64(-IndexerPruneInterval)
 
 	// Indexer loop
 	for {
@@ -127,10 +148,21 @@ func (service *EVMTxIndexerService) OnStart() error {
 				break
 			}
 			if err := service.evmTxIndexer.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+				// Retry the block instead of skipping it, which would leave a
+				// hole in the index or in a sink.
 				service.Logger.Error("failed to index block", "height", i, "err", err)
+				time.Sleep(IndexBlockRetryInterval)
+				break
 			}
 			lastIndexedHeight = blockResult.Height
 		}
+
+		if service.retainBlocks > 0 && lastIndexedHeight-lastPrunedHeight >= IndexerPruneInterval {
+			if err := service.evmTxIndexer.PruneBlocks(lastIndexedHeight - service.retainBlocks + 1); err != nil {
+				service.Logger.Error("failed to prune indexer", "height", lastIndexedHeight, "err", err)
+			}
+			lastPrunedHeight = lastIndexedHeight
+		}
 	}
 }
 
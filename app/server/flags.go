@@ -50,6 +50,9 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCIndexerRetainBlocks = "json-rpc.indexer-retain-blocks"
+	JSONRPCIndexerSink         = "json-rpc.indexer-sink"
+	JSONRPCIndexerSinkDSN      = "json-rpc.indexer-sink-dsn"
 	JSONRPCEnableMetrics       = "metrics"
 )
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi/debugapi"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -103,7 +104,13 @@ func StartJSONRPC(
 
 	// allocate separate WS connection to Tendermint
 	tmWsClientForRPCWs := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpcapi.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClientForRPCWs, config)
+	var debugAPI *debugapi.DebugAPI
+	for _, api := range apis {
+		if svc, ok := api.Service.(*debugapi.DebugAPI); ok {
+			debugAPI = svc
+		}
+	}
+	wsSrv := rpcapi.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClientForRPCWs, config, debugAPI)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 
+	_ "github.com/lib/pq" // registers the "postgres" indexer sink driver
 	"github.com/spf13/cobra"
 
 	"google.golang.org/grpc"
@@ -197,6 +198,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Int64(JSONRPCIndexerRetainBlocks, config.DefaultIndexerRetainBlocks, "Sets the number of recent blocks kept by the custom tx indexer (0=keep all)") //nolint:lll
+	cmd.Flags().String(JSONRPCIndexerSink, config.DefaultIndexerSink, "Sets the database driver of an external sink of the custom tx indexer (postgres)")           //nolint:lll
+	cmd.Flags().String(JSONRPCIndexerSinkDSN, "", "Sets the data source name of the external sink of the custom tx indexer")
 	cmd.Flags().Bool(JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -604,13 +608,35 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenIndexerSinks opens the external sink of the custom eth indexer set by
+// the "json-rpc.indexer-sink" config. It returns no sinks if none is set.
+func OpenIndexerSinks(ctx *sdkserver.Context) ([]indexer.IndexerSink, error) {
+	driverName := ctx.Viper.GetString(JSONRPCIndexerSink)
+	if driverName == "" {
+		return nil, nil
+	}
+	sink, err := indexer.NewSQLSink(driverName, ctx.Viper.GetString(JSONRPCIndexerSinkDSN))
+	if err != nil {
+		return nil, err
+	}
+	return []indexer.IndexerSink{sink}, nil
+}
+
 func OpenEVMIndexer(
 	ctx *sdkserver.Context, indexerDb dbm.DB, clientCtx client.Context,
 ) (eth.EVMTxIndexer, *EVMTxIndexerService, error) {
 	idxLogger := ctx.Logger.With("indexer", "evm")
-	evmIndexer := indexer.NewEVMTxIndexer(indexerDb, idxLogger, clientCtx)
+	sinks, err := OpenIndexerSinks(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	evmIndexer := indexer.NewEVMTxIndexer(indexerDb, idxLogger, clientCtx, sinks...)
 
-	evmIndexerService := NewEVMIndexerService(evmIndexer, clientCtx.Client.(cmtrpcclient.Client))
+	evmIndexerService := NewEVMIndexerService(
+		evmIndexer,
+		clientCtx.Client.(cmtrpcclient.Client),
+		ctx.Viper.GetInt64(JSONRPCIndexerRetainBlocks),
+	)
 	evmIndexerService.SetLogger(idxLogger)
 
 	errCh := make(chan error)
@@ -0,0 +1,158 @@
+package wasmext
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasm "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+// NibiruWasmMsg is the JSON schema of the Nibiru-specific messages that
+// CosmWasm contracts dispatch as "CosmosMsg::Custom". Exactly one field must
+// be set.
+//
+// Example:
+//
+//	```json
+//	{ "evm_call": { "contract": "0x...", "input": "<base64>", "value": "1000" } }
+//	```
+type NibiruWasmMsg struct {
+	EvmCall *EvmCall `json:"evm_call,omitempty"`
+}
+
+// EvmCall is a custom Wasm message that calls an EVM contract from a CosmWasm
+// contract. The caller in the EVM (msg.sender) is the EVM address that
+// corresponds to the bech32 address of the Wasm contract. It fails when the
+// Wasm contract runs inside an EVM tx, for example through the Wasm
+// precompile.
+type EvmCall struct {
+	// Contract: Address of the EVM contract to call, as hex or bech32.
+	Contract string `json:"contract"`
+	// Input: ABI-encoded call data. Base64 encoded in JSON, as with the
+	// "Binary" type of CosmWasm.
+	Input []byte `json:"input"`
+	// Value: Optional amount of micronibi (unibi) sent with the call.
+	Value *sdkmath.Int `json:"value,omitempty"`
+	// GasLimit: Optional maximum gas of the EVM execution. Defaults to, and is
+	// capped by, the gas remaining in the Wasm gas meter.
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+}
+
+// EvmCallResponse is the JSON-encoded data returned to the Wasm contract for
+// an [EvmCall], for example as the data of a submessage response.
+type EvmCallResponse struct {
+	// Ret: Bytes returned by the EVM contract.
+	Ret []byte `json:"ret"`
+	// GasUsed: Gas used by the EVM execution.
+	GasUsed uint64 `json:"gas_used"`
+	// Logs: EVM event logs emitted by the call.
+	Logs []evm.Log `json:"logs"`
+}
+
+var _ wasmkeeper.Messenger = (*EvmCallMessageHandler)(nil)
+
+// EvmCallMessageHandler handles the [EvmCall] custom Wasm message. Messages
+// that are not an [EvmCall] are passed on to the next handler of the
+// [wasmkeeper.MessageHandlerChain].
+type EvmCallMessageHandler struct {
+	evmKeeper *evmkeeper.Keeper
+}
+
+func NewEvmCallMessageHandler(evmKeeper *evmkeeper.Keeper) EvmCallMessageHandler {
+	return EvmCallMessageHandler{evmKeeper: evmKeeper}
+}
+
+// DispatchMsg implements [wasmkeeper.Messenger].
+func (h EvmCallMessageHandler) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	_ string,
+	msg wasmvmtypes.CosmosMsg,
+) (events []sdk.Event, data [][]byte, err error) {
+	if msg.Custom == nil {
+		return nil, nil, wasm.ErrUnknownMsg
+	}
+	var customMsg NibiruWasmMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+		return nil, nil, sdkioerrors.Wrap(wasm.ErrUnknownMsg, err.Error())
+	}
+	if customMsg.EvmCall == nil {
+		return nil, nil, wasm.ErrUnknownMsg
+	}
+	if h.evmKeeper == nil {
+		return nil, nil, sdkioerrors.Wrap(sdkerrors.ErrLogic, "evm keeper is not set for evm_call")
+	}
+
+	resBz, err := h.handleEvmCall(ctx, contractAddr, *customMsg.EvmCall)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, [][]byte{resBz}, nil
+}
+
+func (h EvmCallMessageHandler) handleEvmCall(
+	ctx sdk.Context, contractAddr sdk.AccAddress, msg EvmCall,
+) ([]byte, error) {
+	contract, err := parseEvmAddr(msg.Contract)
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "evm_call contract: %s", err)
+	}
+	valueWei := big.NewInt(0)
+	if msg.Value != nil {
+		if msg.Value.IsNegative() {
+			return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidCoins, "evm_call value is negative: %s", msg.Value)
+		}
+		valueWei = evm.NativeToWei(msg.Value.BigInt())
+	}
+
+	gasRemaining := ctx.GasMeter().GasRemaining()
+	gasLimit := msg.GasLimit
+	if gasLimit == 0 || gasLimit > gasRemaining {
+		gasLimit = gasRemaining
+	}
+	if blockGasLimit := eth.BlockGasLimit(ctx); blockGasLimit > 0 {
+		gasLimit = min(gasLimit, blockGasLimit)
+	}
+
+	evmResp, err := h.evmKeeper.CallContractFromWasm(
+		ctx, eth.NibiruAddrToEthAddr(contractAddr), contract, msg.Input, valueWei, gasLimit,
+	)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "evm_call")
+	}
+
+	logs := evmResp.Logs
+	if logs == nil {
+		logs = []evm.Log{}
+	}
+	return json.Marshal(EvmCallResponse{
+		Ret:     evmResp.Ret,
+		GasUsed: evmResp.GasUsed,
+		Logs:    logs,
+	})
+}
+
+// parseEvmAddr parses an EVM address given as hex or as a bech32 address.
+func parseEvmAddr(addr string) (gethcommon.Address, error) {
+	if gethcommon.IsHexAddress(addr) {
+		return gethcommon.HexToAddress(addr), nil
+	}
+	nibiAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return gethcommon.Address{}, sdkioerrors.Wrapf(
+			err, "%q is neither a hex nor a bech32 address", addr,
+		)
+	}
+	return eth.NibiruAddrToEthAddr(nibiAddr), nil
+}
@@ -0,0 +1,138 @@
+package wasmext
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// EthCallQueryGasCap is the maximum gas that the EVM execution of an
+// [EthCallQuery] may use, regardless of the gas limit of the query.
+const EthCallQueryGasCap uint64 = 3_000_000
+
+// NibiruWasmQuery is the JSON schema of the Nibiru-specific queries that
+// CosmWasm contracts send as "QueryRequest::Custom". Exactly one field must be
+// set.
+//
+// Example:
+//
+//	```json
+//	{ "eth_call": { "contract": "0x...", "input": "<base64>" } }
+//	```
+type NibiruWasmQuery struct {
+	EthCall *EthCallQuery `json:"eth_call,omitempty"`
+}
+
+// EthCallQuery is a custom Wasm query that executes a read-only call to an
+// EVM contract, for example to call the view functions of a Solidity
+// contract. State changes of the call are discarded.
+type EthCallQuery struct {
+	// From: Optional sender (msg.sender) of the call as hex or bech32.
+	// Defaults to the zero address.
+	From string `json:"from,omitempty"`
+	// Contract: Address of the EVM contract to call, as hex or bech32.
+	Contract string `json:"contract"`
+	// Input: ABI-encoded call data. Base64 encoded in JSON, as with the
+	// "Binary" type of CosmWasm.
+	Input []byte `json:"input"`
+	// GasLimit: Optional maximum gas of the EVM execution. Defaults to, and is
+	// capped by, [EthCallQueryGasCap] and the gas remaining for the query.
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+}
+
+// EthCallQueryResponse is the JSON-encoded response of an [EthCallQuery].
+type EthCallQueryResponse struct {
+	// Ret: Bytes returned by the EVM contract.
+	Ret []byte `json:"ret"`
+	// GasUsed: Gas used by the EVM execution.
+	GasUsed uint64 `json:"gas_used"`
+}
+
+// EvmCustomQuerier returns the [wasmkeeper.CustomQuerier] that handles the
+// [NibiruWasmQuery] custom queries.
+//
+// The querier is deterministic: the call runs against the committed state of
+// the current block on an isolated [statedb.StateDB], and its gas is bounded
+// by [EthCallQueryGasCap] and metered by the gas meter of the query.
+func EvmCustomQuerier(evmKeeper *evmkeeper.Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var customQuery NibiruWasmQuery
+		if err := json.Unmarshal(request, &customQuery); err != nil {
+			return nil, sdkioerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if customQuery.EthCall == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown custom query variant"}
+		}
+		if evmKeeper == nil {
+			return nil, sdkioerrors.Wrap(sdkerrors.ErrLogic, "evm keeper is not set for eth_call")
+		}
+		return handleEthCallQuery(ctx, evmKeeper, *customQuery.EthCall)
+	}
+}
+
+func handleEthCallQuery(
+	ctx sdk.Context, evmKeeper *evmkeeper.Keeper, query EthCallQuery,
+) ([]byte, error) {
+	contract, err := parseEvmAddr(query.Contract)
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "eth_call contract: %s", err)
+	}
+	var from gethcommon.Address
+	if query.From != "" {
+		from, err = parseEvmAddr(query.From)
+		if err != nil {
+			return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "eth_call from: %s", err)
+		}
+	}
+
+	gasLimit := min(EthCallQueryGasCap, ctx.GasMeter().GasRemaining())
+	if query.GasLimit > 0 {
+		gasLimit = min(gasLimit, query.GasLimit)
+	}
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &contract,
+		From:             from,
+		Nonce:            evmKeeper.GetAccNonce(ctx, from),
+		Value:            unusedBigInt,
+		GasLimit:         gasLimit,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             query.Input,
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+
+	// The StateDB is not set on the bank keeper of the EVM module, so the query
+	// can't interfere with an EVM transaction that is in progress.
+	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
+	stateDB := statedb.New(ctx, evmKeeper, txConfig)
+	evmObj := evmKeeper.NewEVM(ctx, evmMsg, evmKeeper.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	evmResp, err := evmKeeper.CallContractWithInput(
+		ctx, evmObj, from, &contract, false /*commit*/, query.Input, gasLimit,
+	)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "eth_call")
+	}
+
+	return json.Marshal(EthCallQueryResponse{
+		Ret:     evmResp.Ret,
+		GasUsed: evmResp.GasUsed,
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	devgas "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
 	epochs "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	inflation "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 	oracle "github.com/NibiruChain/nibiru/v2/x/oracle/types"
 	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
@@ -135,6 +136,17 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		// nibiru sudo
 		"/nibiru.sudo.v1.Query/QuerySudoers": new(sudotypes.QuerySudoersResponse),
 
+		// nibiru evm
+		//
+		// "EthCall" is not accepted here because its gas cap is part of the
+		// request. Wasm contracts use the "eth_call" custom query instead. See
+		// [EthCallQuery].
+		"/eth.evm.v1.Query/EthAccount":      new(evm.QueryEthAccountResponse),
+		"/eth.evm.v1.Query/Balance":         new(evm.QueryBalanceResponse),
+		"/eth.evm.v1.Query/Storage":         new(evm.QueryStorageResponse),
+		"/eth.evm.v1.Query/Code":            new(evm.QueryCodeResponse),
+		"/eth.evm.v1.Query/FunTokenMapping": new(evm.QueryFunTokenMappingResponse),
+
 		// nibiru devgas
 		"/nibiru.devgas.v1.Query/FeeShares":             new(devgas.QueryFeeSharesResponse),
 		"/nibiru.devgas.v1.Query/FeeShare":              new(devgas.QueryFeeShareResponse),
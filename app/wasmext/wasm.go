@@ -2,6 +2,7 @@ package wasmext
 
 import (
 	"github.com/NibiruChain/nibiru/v2/x/evm"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
 
 	sdkioerrors "cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -27,6 +28,7 @@ func NibiruWasmOptions(
 			grpcQueryRouter,
 			appCodec,
 		),
+		Custom: EvmCustomQuerier(msgHandlerArgs.EvmKeeper),
 	})
 
 	wasmMsgHandlerOption := wasmkeeper.WithMessageHandler(WasmMessageHandler(msgHandlerArgs))
@@ -51,7 +53,10 @@ func (h SDKMessageHandler) handleSdkMessage(ctx sdk.Context, contractAddr sdk.Ad
 
 	msgTypeUrl := sdk.MsgTypeURL(msg)
 	if msgTypeUrl == sdk.MsgTypeURL(new(evm.MsgEthereumTx)) {
-		return nil, sdkioerrors.Wrap(sdkerrors.ErrUnauthorized, "Wasm VM to EVM call pattern is not yet supported")
+		return nil, sdkioerrors.Wrap(
+			sdkerrors.ErrUnauthorized,
+			"Wasm VM to EVM call pattern is not yet supported for MsgEthereumTx: use the \"evm_call\" custom message instead",
+		)
 	}
 
 	// find the handler and execute it
@@ -76,6 +81,8 @@ type MsgHandlerArgs struct {
 	BankKeeper       wasm.Burner
 	Unpacker         sdkcodec.AnyUnpacker
 	PortSource       wasm.ICS20TransferPortSource
+	// EvmKeeper handles the "evm_call" custom message. See [EvmCall].
+	EvmKeeper *evmkeeper.Keeper
 }
 
 // SDKMessageHandler can handles messages that can be encoded into sdk.Message types and routed.
@@ -102,6 +109,7 @@ func WasmMessageHandler(
 ) wasmkeeper.Messenger {
 	encoders := wasmkeeper.DefaultEncoders(args.Unpacker, args.PortSource)
 	return wasmkeeper.NewMessageHandlerChain(
+		NewEvmCallMessageHandler(args.EvmKeeper),
 		NewSDKMessageHandler(args.Router, encoders),
 		wasmkeeper.NewIBCRawPacketHandler(args.Ics4Wrapper, args.ChannelKeeper, args.CapabilityKeeper),
 		wasmkeeper.NewBurnCoinMessageHandler(args.BankKeeper),
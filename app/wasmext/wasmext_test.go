@@ -1,6 +1,7 @@
 package wasmext_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -11,8 +12,10 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/app/wasmext"
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 )
 
@@ -96,3 +99,139 @@ func (s *Suite) TestEvmFilter() {
 	)
 	s.Require().NoError(err)
 }
+
+// TestEvmCall verifies that a Wasm contract can call an EVM contract with the
+// "evm_call" custom message.
+func (s *Suite) TestEvmCall() {
+	deps := evmtest.NewTestDeps()
+	wasmMsgHandler := wasmext.WasmMessageHandler(deps.App.WasmMsgHandlerArgs)
+
+	s.T().Log("Deploy an ERC20 owned by the (mock) Wasm contract")
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	erc20Addr := deployResp.ContractAddr
+	wasmContractAddr := deps.Sender.NibiruAddr
+	wasmContractEthAddr := deps.Sender.EthAddr
+
+	evmObj, _ := deps.NewEVM()
+	balanceBefore, err := deps.EvmKeeper.ERC20().BalanceOf(
+		erc20Addr, wasmContractEthAddr, deps.Ctx, evmObj,
+	)
+	s.Require().NoError(err)
+	// Detach the StateDB of the query, as the end of an EVM tx does.
+	deps.EvmKeeper.Bank.StateDB = nil
+
+	recipient := evmtest.NewEthPrivAcc()
+	amount := big.NewInt(420)
+	input, err := embeds.SmartContract_TestERC20.ABI.Pack("transfer", recipient.EthAddr, amount)
+	s.Require().NoError(err)
+
+	dispatchEvmCall := func(evmCall wasmext.EvmCall) ([][]byte, error) {
+		customBz, err := json.Marshal(wasmext.NibiruWasmMsg{EvmCall: &evmCall})
+		s.Require().NoError(err)
+		_, data, err := wasmMsgHandler.DispatchMsg(
+			deps.Ctx, wasmContractAddr, "ibcport-unused", wasmvm.CosmosMsg{Custom: customBz},
+		)
+		return data, err
+	}
+
+	s.T().Log("Dispatch evm_call with the bech32 address of the ERC20")
+	gasBefore := deps.Ctx.GasMeter().GasConsumed()
+	data, err := dispatchEvmCall(wasmext.EvmCall{
+		Contract: eth.EthAddrToNibiruAddr(erc20Addr).String(),
+		Input:    input,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(data, 1)
+	s.Greater(deps.Ctx.GasMeter().GasConsumed(), gasBefore, "expect Wasm gas meter to consume EVM gas")
+
+	var resp wasmext.EvmCallResponse
+	s.Require().NoError(json.Unmarshal(data[0], &resp))
+	s.NotZero(resp.GasUsed)
+	s.Len(resp.Logs, 1, "expect ERC20 Transfer event log")
+	out, err := embeds.SmartContract_TestERC20.ABI.Unpack("transfer", resp.Ret)
+	s.Require().NoError(err)
+	s.Equal(true, out[0])
+
+	evmObj, _ = deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, erc20Addr, recipient.EthAddr, amount, "recipient",
+	)
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, erc20Addr, wasmContractEthAddr,
+		new(big.Int).Sub(balanceBefore, amount), "wasm contract",
+	)
+
+	s.T().Log("Dispatch evm_call that reverts")
+	tooMuch := new(big.Int).Add(balanceBefore, big.NewInt(1))
+	input, err = embeds.SmartContract_TestERC20.ABI.Pack("transfer", recipient.EthAddr, tooMuch)
+	s.Require().NoError(err)
+	_, err = dispatchEvmCall(wasmext.EvmCall{Contract: erc20Addr.Hex(), Input: input})
+	s.Require().ErrorContains(err, "evm_call")
+
+	s.T().Log("Dispatch evm_call with an invalid contract address")
+	_, err = dispatchEvmCall(wasmext.EvmCall{Contract: "not-an-address", Input: input})
+	s.Require().ErrorContains(err, "neither a hex nor a bech32 address")
+
+	s.T().Log("Dispatch evm_call while an EVM tx is executing (EVM -> Wasm -> EVM)")
+	// An EVM tx that calls the Wasm precompile runs the Wasm contract with the
+	// StateDB of the tx attached to the bank keeper.
+	evmObj, outerStateDB := deps.NewEVM()
+	input, err = embeds.SmartContract_TestERC20.ABI.Pack("transfer", recipient.EthAddr, amount)
+	s.Require().NoError(err)
+	_, err = dispatchEvmCall(wasmext.EvmCall{Contract: erc20Addr.Hex(), Input: input})
+	s.Require().ErrorContains(err, evm.ErrNestedEvmCall.Error())
+	s.Require().Same(outerStateDB, deps.EvmKeeper.Bank.StateDB,
+		"the StateDB of the outer EVM tx must stay attached")
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, erc20Addr, recipient.EthAddr, amount, "recipient",
+	)
+}
+
+// TestEthCallQuery verifies that a Wasm contract can call view functions of an
+// EVM contract with the "eth_call" custom query.
+func (s *Suite) TestEthCallQuery() {
+	deps := evmtest.NewTestDeps()
+	querier := wasmext.EvmCustomQuerier(deps.App.EvmKeeper)
+
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	erc20Addr := deployResp.ContractAddr
+
+	input, err := embeds.SmartContract_TestERC20.ABI.Pack("balanceOf", deps.Sender.EthAddr)
+	s.Require().NoError(err)
+
+	query := func(ctx sdk.Context, ethCall wasmext.EthCallQuery) ([]byte, error) {
+		reqBz, err := json.Marshal(wasmext.NibiruWasmQuery{EthCall: &ethCall})
+		s.Require().NoError(err)
+		return querier(ctx, reqBz)
+	}
+
+	s.T().Log("Query ERC20 balance with eth_call")
+	ctx := deps.Ctx.WithGasMeter(sdk.NewGasMeter(wasmext.EthCallQueryGasCap))
+	resBz, err := query(ctx, wasmext.EthCallQuery{
+		From:     deps.Sender.NibiruAddr.String(),
+		Contract: erc20Addr.Hex(),
+		Input:    input,
+	})
+	s.Require().NoError(err)
+	var res wasmext.EthCallQueryResponse
+	s.Require().NoError(json.Unmarshal(resBz, &res))
+	s.NotZero(res.GasUsed)
+	s.GreaterOrEqual(ctx.GasMeter().GasConsumed(), res.GasUsed, "expect query gas meter to consume EVM gas")
+
+	evmObj, _ := deps.NewEVM()
+	wantBalance, err := deps.EvmKeeper.ERC20().BalanceOf(erc20Addr, deps.Sender.EthAddr, deps.Ctx, evmObj)
+	s.Require().NoError(err)
+	out, err := embeds.SmartContract_TestERC20.ABI.Unpack("balanceOf", res.Ret)
+	s.Require().NoError(err)
+	s.Equal(wantBalance.String(), out[0].(*big.Int).String())
+
+	s.T().Log("eth_call fails when the gas limit is too low")
+	_, err = query(ctx, wasmext.EthCallQuery{Contract: erc20Addr.Hex(), Input: input, GasLimit: 1})
+	s.Require().ErrorContains(err, "eth_call")
+
+	s.T().Log("Unknown custom queries are unsupported")
+	_, err = querier(ctx, []byte(`{"unknown":{}}`))
+	s.Require().ErrorContains(err, "unknown custom query variant")
+}
@@ -150,6 +150,10 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 
 		// EVM Tx Indexer force catch up command
 		server.NewEVMTxIndexCmd(),
+		// EVM Tx Indexer re-index command, which backfills the log index
+		server.NewEVMTxReindexCmd(),
+		// EVM Tx Indexer transaction history of an address
+		server.NewEVMTxHistoryCmd(),
 	)
 
 	// TODO add rosettaj
@@ -5,6 +5,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -17,4 +18,27 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// LogIndexRange returns the first and last blocks of the contiguous range
+	// of blocks whose logs are indexed, or -1 for both if no logs are indexed.
+	LogIndexRange() (first, last int64, err error)
+	// GetLogs returns the indexed logs of a block range that match the
+	// address and topic criteria of "eth_getLogs". It returns an error if
+	// more than "limit" logs match.
+	GetLogs(
+		fromBlock, toBlock int64,
+		addresses []common.Address,
+		topics [][]common.Hash,
+		limit int,
+	) ([]*gethcore.Log, error)
+
+	// GetTxsByAddress returns a page of the hashes of the eth txs sent by or
+	// to an address in a block range, in chain order, and the cursor of the
+	// next page, which is nil for the last page.
+	GetTxsByAddress(
+		address common.Address,
+		fromBlock, toBlock int64,
+		cursor []byte,
+		limit int,
+	) (txHashes []common.Hash, nextCursor []byte, err error)
 }
@@ -0,0 +1,240 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdkioerrors "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+// Key prefixes of the address indexes, which give the transaction history of
+// an account: the eth txs it sent and the eth txs it received.
+const (
+	// KeyPrefixTxFrom: (sender, block number, tx index) -> tx hash
+	KeyPrefixTxFrom = 7
+	// KeyPrefixTxTo: (recipient, block number, tx index) -> tx hash
+	KeyPrefixTxTo = 8
+	// KeyPrefixTxAddresses: (block number, tx index) -> sender and recipients,
+	// which locates the address index entries of a tx when it is pruned.
+	KeyPrefixTxAddresses = 9
+
+	// txPositionLength is the length of the (block number, tx index) suffix of
+	// the address index keys.
+	txPositionLength = 8 + 8
+)
+
+// funTokenRecipientArg maps the FunToken precompile methods that send funds to
+// the position of their "to" argument.
+var funTokenRecipientArg = map[string]int{
+	"sendToBank":  2,
+	"sendToEvm":   2,
+	"bankMsgSend": 0,
+}
+
+// TxFromKey returns the key for db entry:
+// `(sender, block number, tx index) -> tx hash`
+func TxFromKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	key := append([]byte{KeyPrefixTxFrom}, address.Bytes()...)
+	return append(key, txPosition(blockNumber, txIndex)...)
+}
+
+// TxToKey returns the key for db entry:
+// `(recipient, block number, tx index) -> tx hash`
+func TxToKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	key := append([]byte{KeyPrefixTxTo}, address.Bytes()...)
+	return append(key, txPosition(blockNumber, txIndex)...)
+}
+
+// TxAddressesKey returns the key for db entry:
+// `(block number, tx index) -> sender and recipients`
+func TxAddressesKey(blockNumber int64, txIndex int32) []byte {
+	return append([]byte{KeyPrefixTxAddresses}, txPosition(blockNumber, txIndex)...)
+}
+
+func txPosition(blockNumber int64, txIndex int32) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(blockNumber)), sdk.Uint64ToBigEndian(uint64(txIndex))...)
+}
+
+// saveTxAddresses adds an eth tx to the indexes of its sender and recipients
+// in the kv db batch.
+func saveTxAddresses(batch dbm.Batch, tx *IndexedTx) error {
+	height, ethTxIndex := tx.Result.Height, tx.Result.EthTxIndex
+	if err := batch.Set(TxFromKey(tx.From, height, ethTxIndex), tx.Hash.Bytes()); err != nil {
+		return sdkioerrors.Wrap(err, "set tx from key")
+	}
+	addresses := tx.From.Bytes()
+	for _, recipient := range tx.Recipients {
+		if err := batch.Set(TxToKey(recipient, height, ethTxIndex), tx.Hash.Bytes()); err != nil {
+			return sdkioerrors.Wrap(err, "set tx to key")
+		}
+		addresses = append(addresses, recipient.Bytes()...)
+	}
+	if err := batch.Set(TxAddressesKey(height, ethTxIndex), addresses); err != nil {
+		return sdkioerrors.Wrap(err, "set tx addresses key")
+	}
+	return nil
+}
+
+// deleteTxAddresses deletes the address index entries of the tx stored under
+// a [TxAddressesKey] in the kv db batch.
+func deleteTxAddresses(batch dbm.Batch, txAddressesKey, addresses []byte) error {
+	position := txAddressesKey[1:]
+	for i := 0; i+common.AddressLength <= len(addresses); i += common.AddressLength {
+		prefix := byte(KeyPrefixTxTo)
+		if i == 0 {
+			prefix = KeyPrefixTxFrom
+		}
+		key := append([]byte{prefix}, addresses[i:i+common.AddressLength]...)
+		if err := batch.Delete(append(key, position...)); err != nil {
+			return sdkioerrors.Wrap(err, "delete tx address key")
+		}
+	}
+	if err := batch.Delete(txAddressesKey); err != nil {
+		return sdkioerrors.Wrap(err, "delete tx addresses key")
+	}
+	return nil
+}
+
+// txRecipients returns the accounts that an eth tx sends to:
+//   - The "to" address of the tx.
+//   - The address of the contract created by a contract creation tx.
+//   - The recipient of the funds sent by a call to the FunToken precompile.
+//
+// The created contract and FunToken recipients are only included if the tx
+// succeeded, since they receive nothing otherwise.
+func txRecipients(
+	tx *gethcore.Transaction, sender common.Address, failed bool,
+) []common.Address {
+	to := tx.To()
+	switch {
+	case to == nil && failed:
+		return nil
+	case to == nil:
+		return []common.Address{crypto.CreateAddress(sender, tx.Nonce())}
+	case *to != precompile.PrecompileAddr_FunToken || failed:
+		return []common.Address{*to}
+	}
+
+	recipients := []common.Address{*to}
+	if funTokenRecipient, ok := parseFunTokenRecipient(tx.Data()); ok {
+		recipients = append(recipients, funTokenRecipient)
+	}
+	return recipients
+}
+
+// parseFunTokenRecipient returns the "to" argument of a call to a FunToken
+// precompile method that sends funds. The recipient may be a hex or a Bech32
+// address.
+func parseFunTokenRecipient(input []byte) (common.Address, bool) {
+	if len(input) < 4 {
+		return common.Address{}, false
+	}
+	abi := embeds.SmartContract_FunToken.ABI
+	method, err := abi.MethodById(input[:4])
+	if err != nil {
+		return common.Address{}, false
+	}
+	argIdx, ok := funTokenRecipientArg[method.Name]
+	if !ok {
+		return common.Address{}, false
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil || len(args) <= argIdx {
+		return common.Address{}, false
+	}
+	to, ok := args[argIdx].(string)
+	if !ok {
+		return common.Address{}, false
+	}
+	if err := eth.ValidateAddress(to); err == nil {
+		return common.HexToAddress(to), true
+	}
+	nibiAddr, err := sdk.AccAddressFromBech32(to)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return eth.NibiruAddrToEthAddr(nibiAddr), true
+}
+
+// GetTxsByAddress returns the hashes of the eth txs sent by or to an address
+// in the blocks from "fromBlock" to "toBlock", in chain order, with at most
+// "limit" txs per page.
+//
+// Pages are chained with cursors: "cursor" is nil for the first page and the
+// returned "nextCursor" is passed to get the following page. "nextCursor" is
+// nil for the last page.
+func (indexer *EVMTxIndexer) GetTxsByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	cursor []byte,
+	limit int,
+) (txHashes []common.Hash, nextCursor []byte, err error) {
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("limit must be positive, got %d", limit)
+	}
+	start := txPosition(fromBlock, 0)
+	if cursor != nil {
+		if len(cursor) != txPositionLength {
+			return nil, nil, fmt.Errorf(
+				"invalid cursor length, expect: %d, got: %d", txPositionLength, len(cursor))
+		}
+		if bytes.Compare(cursor, start) > 0 {
+			start = cursor
+		}
+	}
+	end := txPosition(toBlock+1, 0)
+	if fromBlock > toBlock || bytes.Compare(start, end) >= 0 {
+		return []common.Hash{}, nil, nil
+	}
+
+	// The first "limit + 1" txs of the history are among the first "limit + 1"
+	// txs of each index. The extra tx gives the cursor of the next page.
+	txs := make(map[string]common.Hash)
+	positions := [][]byte{}
+	for _, prefix := range []byte{KeyPrefixTxFrom, KeyPrefixTxTo} {
+		addrPrefix := append([]byte{prefix}, address.Bytes()...)
+		it, err := indexer.db.Iterator(
+			append(append([]byte{}, addrPrefix...), start...),
+			append(append([]byte{}, addrPrefix...), end...),
+		)
+		if err != nil {
+			return nil, nil, sdkioerrors.Wrap(err, "GetTxsByAddress")
+		}
+		for count := 0; it.Valid() && count <= limit; it.Next() {
+			key := it.Key()
+			position := string(key[len(key)-txPositionLength:])
+			if _, seen := txs[position]; !seen {
+				positions = append(positions, []byte(position))
+			}
+			txs[position] = common.BytesToHash(it.Value())
+			count++
+		}
+		if err := it.Close(); err != nil {
+			return nil, nil, sdkioerrors.Wrap(err, "GetTxsByAddress")
+		}
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return bytes.Compare(positions[i], positions[j]) < 0
+	})
+
+	if len(positions) > limit {
+		nextCursor = positions[limit]
+		positions = positions[:limit]
+	}
+	txHashes = make([]common.Hash, len(positions))
+	for i, position := range positions {
+		txHashes[i] = txs[string(position)]
+	}
+	return txHashes, nextCursor, nil
+}
@@ -3,6 +3,7 @@ package indexer
 
 import (
 	"fmt"
+	"slices"
 
 	sdkioerrors "cosmossdk.io/errors"
 	dbm "github.com/cometbft/cometbft-db"
@@ -35,23 +36,60 @@ type EVMTxIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// sinks: Stores that indexed blocks are written to. The extra sinks given
+	// to [NewEVMTxIndexer] come first, and the last sink is the kv db.
+	sinks []IndexerSink
 }
 
-// NewEVMTxIndexer creates the EVMTxIndexer
-func NewEVMTxIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *EVMTxIndexer {
-	return &EVMTxIndexer{db, logger, clientCtx}
+// NewEVMTxIndexer creates the EVMTxIndexer. Indexed blocks are written to the
+// kv db, which serves the queries of the indexer, and to the extra sinks.
+func NewEVMTxIndexer(
+	db dbm.DB, logger log.Logger, clientCtx client.Context, extraSinks ...IndexerSink,
+) *EVMTxIndexer {
+	indexer := &EVMTxIndexer{db: db, logger: logger, clientCtx: clientCtx}
+	indexer.sinks = append(slices.Clone(extraSinks), kvSink{indexer})
+	return indexer
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
 // - Iterates over all the Txs in Block
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
-// - Builds and stores indexer.TxResult based on parsed events for every message
+// - Builds indexer.TxResult based on parsed events for every message
+// - Parses the logs and FunToken conversions of the block
+// - Writes the txs, logs and conversions to every sink, ending with the kv db,
+// which stores the txs, the address indexes and the log index
+//
+// The block is only written to the kv db once every extra sink has written it.
+// If a sink fails, the block is not indexed and the error of the sink is
+// returned, so that [EVMTxIndexer.LastIndexedBlock] never skips a block that a
+// sink is missing and the block can be indexed again.
 func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
+	indexedBlock, err := indexer.indexedBlock(block, txResults)
+	if err != nil {
+		return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
+	}
+	for _, sink := range indexer.sinks {
+		if err := sink.WriteBlock(indexedBlock); err != nil {
+			return sdkioerrors.Wrapf(err, "IndexBlock %d, write to %T", height, sink)
+		}
+	}
+	return nil
+}
+
+// blockEthTx is an eth tx of a block with the result stored by the indexer.
+type blockEthTx struct {
+	msg    *evm.MsgEthereumTx
+	result eth.TxResult
+}
 
-	batch := indexer.db.NewBatch()
-	defer batch.Close()
+// blockEthTxs returns the eth txs of a block that are indexed, in order.
+func (indexer *EVMTxIndexer) blockEthTxs(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx,
+) []blockEthTx {
+	height := block.Header.Height
+	ethTxs := []blockEthTx{}
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
@@ -86,7 +124,6 @@ func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci
 		var cumulativeGasUsed uint64
 		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg := msg.(*evm.MsgEthereumTx)
-			txHash := common.HexToHash(ethMsg.Hash)
 
 			txResult := eth.TxResult{
 				Height:     height,
@@ -121,15 +158,10 @@ func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(indexer.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
-			}
+			ethTxs = append(ethTxs, blockEthTx{msg: ethMsg, result: txResult})
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return sdkioerrors.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
-	return nil
+	return ethTxs
 }
 
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
@@ -208,12 +240,14 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
-// CloseDBAndExit should be called upon stopping the indexer
+// CloseDBAndExit should be called upon stopping the indexer. It closes the kv
+// db and the extra sinks.
 func (indexer *EVMTxIndexer) CloseDBAndExit() error {
 	indexer.logger.Info("Closing EVMTxIndexer DB")
-	err := indexer.db.Close()
-	if err != nil {
-		return sdkioerrors.Wrap(err, "CloseDBAndExit")
+	for _, sink := range indexer.sinks {
+		if err := sink.Close(); err != nil {
+			return sdkioerrors.Wrap(err, "CloseDBAndExit")
+		}
 	}
 	return nil
 }
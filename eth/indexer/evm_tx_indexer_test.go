@@ -10,8 +10,11 @@ import (
 	tmlog "github.com/cometbft/cometbft/libs/log"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app"
@@ -19,7 +22,9 @@ import (
 	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	evmtest "github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
 func TestEVMTxIndexer(t *testing.T) {
@@ -200,3 +205,428 @@ func TestEVMTxIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMTxIndexerLogs(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	eth.RegisterInterfaces(encCfg.InterfaceRegistry)
+	evm.RegisterInterfaces(encCfg.InterfaceRegistry)
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	addrA := common.BigToAddress(big.NewInt(0xa))
+	addrB := common.BigToAddress(big.NewInt(0xb))
+	topic1 := common.BigToHash(big.NewInt(1))
+	topic2 := common.BigToHash(big.NewInt(2))
+
+	// txLogsEvent builds the ABCI event of a tx with one log per address, with
+	// the given topics and block-wide log indexes starting at "firstIndex".
+	txLogsEvent := func(height int64, firstIndex uint64, topics []common.Hash, addrs ...common.Address) abci.Event {
+		logs := []evm.Log{}
+		for i, addr := range addrs {
+			logs = append(logs, evm.NewLogFromEth(&gethcore.Log{
+				Address:     addr,
+				Topics:      topics,
+				BlockNumber: uint64(height),
+				Index:       uint(firstIndex) + uint(i),
+			}))
+		}
+		event, err := sdk.TypedEventToEvent(&evm.EventTxLog{Logs: logs})
+		require.NoError(t, err)
+		return abci.Event(event)
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	for height := int64(1); height <= 3; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{
+			{Code: 0, Events: []abci.Event{
+				txLogsEvent(height, 0, []common.Hash{topic1}, addrA, addrB),
+			}},
+			{Code: 0, Events: []abci.Event{
+				txLogsEvent(height, 2, []common.Hash{topic2, topic1}, addrA),
+			}},
+		}))
+	}
+
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expLogs   [][2]uint64 // (block number, log index)
+	}{
+		{
+			name:    "wildcard",
+			from:    2,
+			to:      3,
+			expLogs: [][2]uint64{{2, 0}, {2, 1}, {2, 2}, {3, 0}, {3, 1}, {3, 2}},
+		},
+		{
+			name:      "address",
+			from:      1,
+			to:        2,
+			addresses: []common.Address{addrB},
+			expLogs:   [][2]uint64{{1, 1}, {2, 1}},
+		},
+		{
+			name:      "addresses: merged in chain order",
+			from:      2,
+			to:        3,
+			addresses: []common.Address{addrB, addrA},
+			expLogs:   [][2]uint64{{2, 0}, {2, 1}, {2, 2}, {3, 0}, {3, 1}, {3, 2}},
+		},
+		{
+			name:    "topic at first position",
+			from:    1,
+			to:      3,
+			topics:  [][]common.Hash{{topic2}},
+			expLogs: [][2]uint64{{1, 2}, {2, 2}, {3, 2}},
+		},
+		{
+			name:    "topic after a wildcard position",
+			from:    3,
+			to:      3,
+			topics:  [][]common.Hash{{}, {topic1}},
+			expLogs: [][2]uint64{{3, 2}},
+		},
+		{
+			name:      "address and topics",
+			from:      1,
+			to:        1,
+			addresses: []common.Address{addrA, addrB},
+			topics:    [][]common.Hash{{topic1, topic2}},
+			expLogs:   [][2]uint64{{1, 0}, {1, 1}, {1, 2}},
+		},
+		{
+			name:      "no match",
+			from:      1,
+			to:        3,
+			addresses: []common.Address{addrB},
+			topics:    [][]common.Hash{{topic2}},
+			expLogs:   [][2]uint64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, 100)
+			require.NoError(t, err)
+			gotLogs := [][2]uint64{}
+			for _, log := range logs {
+				gotLogs = append(gotLogs, [2]uint64{log.BlockNumber, uint64(log.Index)})
+			}
+			require.Equal(t, tc.expLogs, gotLogs)
+		})
+	}
+
+	t.Run("limit", func(t *testing.T) {
+		_, err := idxer.GetLogs(1, 3, nil, nil, 8)
+		require.ErrorContains(t, err, "query returned more than 8 results")
+
+		logs, err := idxer.GetLogs(1, 3, nil, nil, 9)
+		require.NoError(t, err)
+		require.Len(t, logs, 9)
+
+		_, err = idxer.GetLogs(1, 3, []common.Address{addrA, addrB}, nil, 8)
+		require.ErrorContains(t, err, "query returned more than 8 results")
+	})
+
+	t.Run("block outside of the range starts a new range", func(t *testing.T) {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 10}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{}))
+
+		first, last, err := idxer.LogIndexRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(10), first)
+		require.Equal(t, int64(10), last)
+	})
+}
+
+// testEthTxSigner signs eth txs and wraps them in Cosmos txs with successful
+// tx results, like the txs of the blocks that the indexer indexes.
+type testEthTxSigner struct {
+	t         *testing.T
+	clientCtx client.Context
+	sender    common.Address
+	signer    keyring.Signer
+}
+
+func newTestEthTxSigner(t *testing.T) testEthTxSigner {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	encCfg := app.MakeEncodingConfig()
+	eth.RegisterInterfaces(encCfg.InterfaceRegistry)
+	evm.RegisterInterfaces(encCfg.InterfaceRegistry)
+	return testEthTxSigner{
+		t: t,
+		clientCtx: client.Context{}.
+			WithTxConfig(encCfg.TxConfig).
+			WithCodec(encCfg.Codec),
+		sender: common.BytesToAddress(priv.PubKey().Address().Bytes()),
+		signer: evmtest.NewSigner(priv),
+	}
+}
+
+// ethTx signs an eth tx of the sender and returns its hash, the encoded
+// wrapper tx and a successful tx result at index "ethTxIndex".
+func (s testEthTxSigner) ethTx(
+	args evm.EvmTxArgs, ethTxIndex int, events ...abci.Event,
+) (common.Hash, cmttypes.Tx, *abci.ResponseDeliverTx) {
+	tx := evm.NewTx(&args)
+	tx.From = s.sender.Hex()
+	require.NoError(s.t, tx.Sign(gethcore.LatestSignerForChainID(nil), s.signer))
+	txHash := tx.AsTransaction().Hash()
+	sdkTx, err := tx.BuildTx(s.clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+	require.NoError(s.t, err)
+	txBz, err := s.clientCtx.TxConfig.TxEncoder()(sdkTx)
+	require.NoError(s.t, err)
+	return txHash, txBz, &abci.ResponseDeliverTx{
+		Code: 0,
+		Events: append([]abci.Event{{
+			Type: evm.PendingEthereumTxEvent,
+			Attributes: []abci.EventAttribute{
+				{Key: evm.PendingEthereumTxEventAttrEthHash, Value: txHash.Hex()},
+				{Key: evm.PendingEthereumTxEventAttrIndex, Value: fmt.Sprint(ethTxIndex)},
+			},
+		}}, events...),
+	}
+}
+
+func TestEVMTxIndexerAddresses(t *testing.T) {
+	txSigner := newTestEthTxSigner(t)
+	sender, clientCtx, ethTx := txSigner.sender, txSigner.clientCtx, txSigner.ethTx
+
+	recipient := common.BigToAddress(big.NewInt(1))
+	funTokenRecipient := common.BigToAddress(big.NewInt(2))
+	funTokenInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+		"sendToBank", common.BigToAddress(big.NewInt(3)), big.NewInt(1),
+		eth.EthAddrToNibiruAddr(funTokenRecipient).String(),
+	)
+	require.NoError(t, err)
+	funTokenPrecompile := precompile.PrecompileAddr_FunToken
+
+	transferHash, transferBz, transferRes := ethTx(evm.EvmTxArgs{
+		Nonce: 0, To: &recipient, Amount: big.NewInt(1000), GasLimit: 21000,
+	}, 0)
+	createHash, createBz, createRes := ethTx(evm.EvmTxArgs{
+		Nonce: 1, Amount: big.NewInt(0), GasLimit: 100_000, Input: []byte{0x00},
+	}, 1)
+	funTokenHash, funTokenBz, funTokenRes := ethTx(evm.EvmTxArgs{
+		Nonce: 2, To: &funTokenPrecompile, Amount: big.NewInt(0), GasLimit: 100_000, Input: funTokenInput,
+	}, 0)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(
+		&cmttypes.Block{
+			Header: cmttypes.Header{Height: 1},
+			Data:   cmttypes.Data{Txs: []cmttypes.Tx{transferBz, createBz}},
+		},
+		[]*abci.ResponseDeliverTx{transferRes, createRes},
+	))
+	require.NoError(t, idxer.IndexBlock(
+		&cmttypes.Block{
+			Header: cmttypes.Header{Height: 2},
+			Data:   cmttypes.Data{Txs: []cmttypes.Tx{funTokenBz}},
+		},
+		[]*abci.ResponseDeliverTx{funTokenRes},
+	))
+
+	testCases := []struct {
+		name      string
+		address   common.Address
+		fromBlock int64
+		toBlock   int64
+		expTxs    []common.Hash
+	}{
+		{
+			name:    "sender",
+			address: sender,
+			toBlock: 2,
+			expTxs:  []common.Hash{transferHash, createHash, funTokenHash},
+		},
+		{
+			name:      "sender in block range",
+			address:   sender,
+			fromBlock: 2,
+			toBlock:   2,
+			expTxs:    []common.Hash{funTokenHash},
+		},
+		{
+			name:    "recipient",
+			address: recipient,
+			toBlock: 2,
+			expTxs:  []common.Hash{transferHash},
+		},
+		{
+			name:    "created contract",
+			address: crypto.CreateAddress(sender, 1),
+			toBlock: 2,
+			expTxs:  []common.Hash{createHash},
+		},
+		{
+			name:    "FunToken precompile and recipient",
+			address: funTokenRecipient,
+			toBlock: 2,
+			expTxs:  []common.Hash{funTokenHash},
+		},
+		{
+			name:    "no txs",
+			address: common.BigToAddress(big.NewInt(42)),
+			toBlock: 2,
+			expTxs:  []common.Hash{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txHashes, nextCursor, err := idxer.GetTxsByAddress(tc.address, tc.fromBlock, tc.toBlock, nil, 10)
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, txHashes)
+			require.Nil(t, nextCursor)
+		})
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		gotTxs := []common.Hash{}
+		var cursor []byte
+		for page := 0; page < 3; page++ {
+			txHashes, nextCursor, err := idxer.GetTxsByAddress(sender, 0, 2, cursor, 1)
+			require.NoError(t, err)
+			require.Len(t, txHashes, 1)
+			gotTxs = append(gotTxs, txHashes...)
+			cursor = nextCursor
+		}
+		require.Nil(t, cursor)
+		require.Equal(t, []common.Hash{transferHash, createHash, funTokenHash}, gotTxs)
+	})
+
+	t.Run("sad: invalid cursor", func(t *testing.T) {
+		_, _, err := idxer.GetTxsByAddress(sender, 0, 2, []byte{1}, 1)
+		require.ErrorContains(t, err, "invalid cursor")
+	})
+}
+
+func TestEVMTxIndexerMaintenance(t *testing.T) {
+	txSigner := newTestEthTxSigner(t)
+	recipient := common.BigToAddress(big.NewInt(1))
+	topic := common.BigToHash(big.NewInt(1))
+
+	// Blocks 1 to 5 have one eth tx each, with one log
+	type testBlock struct {
+		txHash  common.Hash
+		block   *cmttypes.Block
+		results []*abci.ResponseDeliverTx
+	}
+	blocks := make(map[int64]testBlock)
+	for height := int64(1); height <= 5; height++ {
+		logEvent, err := sdk.TypedEventToEvent(&evm.EventTxLog{Logs: []evm.Log{
+			evm.NewLogFromEth(&gethcore.Log{
+				Address: recipient, Topics: []common.Hash{topic}, BlockNumber: uint64(height),
+			}),
+		}})
+		require.NoError(t, err)
+		txHash, txBz, txRes := txSigner.ethTx(evm.EvmTxArgs{
+			Nonce: uint64(height - 1), To: &recipient, Amount: big.NewInt(1), GasLimit: 21000,
+		}, 0, abci.Event(logEvent))
+		blocks[height] = testBlock{
+			txHash: txHash,
+			block: &cmttypes.Block{
+				Header: cmttypes.Header{Height: height},
+				Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+			},
+			results: []*abci.ResponseDeliverTx{txRes},
+		}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), txSigner.clientCtx)
+	for height := int64(1); height <= 5; height++ {
+		if height == 3 {
+			continue // gap, as after a crash
+		}
+		require.NoError(t, idxer.IndexBlock(blocks[height].block, blocks[height].results))
+	}
+
+	t.Run("verify finds the missing block", func(t *testing.T) {
+		for height := int64(1); height <= 5; height++ {
+			indexedTxs, expectedTxs, err := idxer.VerifyBlock(blocks[height].block, blocks[height].results)
+			require.NoError(t, err)
+			require.Equal(t, 1, expectedTxs)
+			if height == 3 {
+				require.Equal(t, 0, indexedTxs)
+			} else {
+				require.Equal(t, 1, indexedTxs)
+			}
+		}
+	})
+
+	t.Run("reindex repairs the missing block", func(t *testing.T) {
+		require.NoError(t, idxer.ReindexBlock(blocks[3].block, blocks[3].results))
+		indexedTxs, expectedTxs, err := idxer.VerifyBlock(blocks[3].block, blocks[3].results)
+		require.NoError(t, err)
+		require.Equal(t, expectedTxs, indexedTxs)
+
+		txResult, err := idxer.GetByTxHash(blocks[3].txHash)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), txResult.Height)
+
+		logs, err := idxer.GetLogs(3, 3, nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+	})
+
+	t.Run("prune keeps the blocks of the retention window", func(t *testing.T) {
+		require.NoError(t, idxer.PruneBlocks(3))
+
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+		_, err = idxer.GetByTxHash(blocks[2].txHash)
+		require.Error(t, err)
+
+		txHashes, _, err := idxer.GetTxsByAddress(recipient, 0, 5, nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{blocks[3].txHash, blocks[4].txHash, blocks[5].txHash}, txHashes)
+
+		firstLog, lastLog, err := idxer.LogIndexRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), firstLog)
+		require.Equal(t, int64(5), lastLog)
+		logs, err := idxer.GetLogs(1, 5, []common.Address{recipient}, [][]common.Hash{{topic}}, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 3)
+	})
+
+	t.Run("prune all blocks leaves the db empty", func(t *testing.T) {
+		require.NoError(t, idxer.PruneBlocks(100))
+
+		firstLog, lastLog, err := idxer.LogIndexRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), firstLog)
+		require.Equal(t, int64(-1), lastLog)
+
+		it, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+		defer it.Close()
+		keys := []string{}
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, fmt.Sprintf("%X", it.Key()))
+		}
+		require.Empty(t, keys)
+	})
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// Key prefixes of the log index. Logs are stored once under their position in
+// the chain, (block number, log index), and indexed by address and by each of
+// their topics, so that "eth_getLogs" queries read only the matching logs.
+const (
+	// KeyPrefixLog: (block number, log index) -> log
+	KeyPrefixLog = 3
+	// KeyPrefixLogAddress: (address, block number, log index) -> nothing
+	KeyPrefixLogAddress = 4
+	// KeyPrefixLogTopic: (topic position, topic, block number, log index) -> nothing
+	KeyPrefixLogTopic = 5
+	// KeyPrefixLogIndexRange: Range of blocks with indexed logs,
+	// (first block number, last block number)
+	KeyPrefixLogIndexRange = 6
+
+	// logPositionLength is the length of the (block number, log index) suffix
+	// of the log index keys.
+	logPositionLength = 8 + 8
+)
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return append([]byte{KeyPrefixLog}, logPosition(blockNumber, logIndex)...)
+}
+
+// LogAddressKey returns the key for db entry:
+// `(address, block number, log index) -> nothing`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, logPosition(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry:
+// `(topic position, topic, block number, log index) -> nothing`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+	return append(key, logPosition(blockNumber, logIndex)...)
+}
+
+func logPosition(blockNumber int64, logIndex uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(blockNumber)), sdk.Uint64ToBigEndian(logIndex)...)
+}
+
+// indexBlockLogs adds the logs of a block to the log index and extends the
+// range of blocks with indexed logs.
+func (indexer *EVMTxIndexer) indexBlockLogs(
+	batch dbm.Batch, height int64, logs []*gethcore.Log,
+) error {
+	for _, log := range logs {
+		evmLog := evm.NewLogFromEth(log)
+		if err := saveLog(indexer.clientCtx.Codec, batch, height, &evmLog); err != nil {
+			return err
+		}
+	}
+
+	first, last, err := indexer.LogIndexRange()
+	if err != nil {
+		return err
+	}
+	// The range only covers contiguous blocks, so indexing a block that is not
+	// next to the range starts a new range.
+	if first < 0 || height < first-1 || height > last+1 {
+		first, last = height, height
+	}
+	return setLogIndexRange(batch, min(first, height), max(last, height))
+}
+
+// saveLog adds a log to the log index in the kv db batch
+func saveLog(codec codec.Codec, batch dbm.Batch, height int64, log *evm.Log) error {
+	if err := batch.Set(LogKey(height, log.Index), codec.MustMarshal(log)); err != nil {
+		return sdkioerrors.Wrap(err, "set log key")
+	}
+	if err := batch.Set(LogAddressKey(common.HexToAddress(log.Address), height, log.Index), []byte{}); err != nil {
+		return sdkioerrors.Wrap(err, "set log address key")
+	}
+	for position, topic := range log.Topics {
+		if err := batch.Set(LogTopicKey(position, common.HexToHash(topic), height, log.Index), []byte{}); err != nil {
+			return sdkioerrors.Wrap(err, "set log topic key")
+		}
+	}
+	return nil
+}
+
+// deleteLog deletes a log stored under a [LogKey] and its address and topic
+// index entries in the kv db batch.
+func deleteLog(codec codec.Codec, batch dbm.Batch, logKey, bz []byte) error {
+	var log evm.Log
+	if err := codec.Unmarshal(bz, &log); err != nil {
+		return sdkioerrors.Wrap(err, "unmarshal log")
+	}
+	height := int64(sdk.BigEndianToUint64(logKey[1:9]))
+	if err := batch.Delete(LogAddressKey(common.HexToAddress(log.Address), height, log.Index)); err != nil {
+		return sdkioerrors.Wrap(err, "delete log address key")
+	}
+	for position, topic := range log.Topics {
+		if err := batch.Delete(LogTopicKey(position, common.HexToHash(topic), height, log.Index)); err != nil {
+			return sdkioerrors.Wrap(err, "delete log topic key")
+		}
+	}
+	if err := batch.Delete(logKey); err != nil {
+		return sdkioerrors.Wrap(err, "delete log key")
+	}
+	return nil
+}
+
+// setLogIndexRange sets the range of blocks with indexed logs in the kv db
+// batch.
+func setLogIndexRange(batch dbm.Batch, first, last int64) error {
+	rangeBz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)
+	if err := batch.Set([]byte{KeyPrefixLogIndexRange}, rangeBz); err != nil {
+		return sdkioerrors.Wrap(err, "set log index range")
+	}
+	return nil
+}
+
+// LogIndexRange returns the first and last blocks of the contiguous range of
+// blocks whose logs are indexed. It returns -1 for both if no logs are indexed.
+func (indexer *EVMTxIndexer) LogIndexRange() (first, last int64, err error) {
+	bz, err := indexer.db.Get([]byte{KeyPrefixLogIndexRange})
+	if err != nil {
+		return 0, 0, sdkioerrors.Wrap(err, "LogIndexRange")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log index range length, expect: 16, got: %d", len(bz))
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil
+}
+
+// GetLogs returns the logs of the blocks from "fromBlock" to "toBlock" that
+// match the address and topic criteria of "eth_getLogs", in chain order. It
+// returns an error if more than "limit" logs match. The caller should check
+// that the blocks are in the [EVMTxIndexer.LogIndexRange].
+//
+// Matching logs are looked up in the address index if addresses are given,
+// then in the topic index of the first topic position with criteria, and
+// otherwise by reading every log in the range. The index entries are read in
+// chain order and the lookup stops at the first log over the limit, so a
+// query never reads more than "limit" + 1 matching logs.
+func (indexer *EVMTxIndexer) GetLogs(
+	fromBlock, toBlock int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) (logs []*gethcore.Log, err error) {
+	if fromBlock > toBlock {
+		return []*gethcore.Log{}, nil
+	}
+
+	var prefixes [][]byte
+	switch topicPosition := firstTopicCriteria(topics); {
+	case len(addresses) > 0:
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+		}
+	case topicPosition >= 0:
+		for _, topic := range topics[topicPosition] {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic, byte(topicPosition)}, topic.Bytes()...))
+		}
+	default:
+		prefixes = [][]byte{{KeyPrefixLog}}
+	}
+
+	positions, err := indexer.newLogPositionIterator(prefixes, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := positions.Close(); closeErr != nil && err == nil {
+			logs, err = nil, sdkioerrors.Wrap(closeErr, "GetLogs")
+		}
+	}()
+
+	logs = []*gethcore.Log{}
+	for position := positions.Next(); position != nil; position = positions.Next() {
+		bz, err := indexer.db.Get(append([]byte{KeyPrefixLog}, position...))
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "GetLogs")
+		}
+		var log evm.Log
+		if err := indexer.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+			return nil, sdkioerrors.Wrap(err, "GetLogs")
+		}
+		ethLog := log.ToEthereum()
+		if !logMatches(ethLog, addresses, topics) {
+			continue
+		}
+		if len(logs) == limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, ethLog)
+	}
+	return logs, nil
+}
+
+// logPositionIterator iterates over the (block number, log index) positions of
+// the keys with any of a set of prefixes in a block range. It merges the
+// iterators of the prefixes, each sorted by position, so the positions come
+// in chain order and without duplicates.
+type logPositionIterator struct {
+	iterators []dbm.Iterator
+}
+
+// newLogPositionIterator returns a [logPositionIterator] over the keys with
+// any of the prefixes in the block range. It must be closed after use.
+func (indexer *EVMTxIndexer) newLogPositionIterator(
+	prefixes [][]byte, fromBlock, toBlock int64,
+) (*logPositionIterator, error) {
+	positions := &logPositionIterator{}
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), logPosition(fromBlock, 0)...)
+		end := append(append([]byte{}, prefix...), logPosition(toBlock+1, 0)...)
+		it, err := indexer.db.Iterator(start, end)
+		if err != nil {
+			_ = positions.Close()
+			return nil, sdkioerrors.Wrap(err, "GetLogs")
+		}
+		positions.iterators = append(positions.iterators, it)
+	}
+	return positions, nil
+}
+
+// Next returns the next position, or nil if there are no positions left.
+func (positions *logPositionIterator) Next() []byte {
+	var next []byte
+	for _, it := range positions.iterators {
+		if !it.Valid() {
+			continue
+		}
+		if position := keyLogPosition(it.Key()); next == nil || bytes.Compare(position, next) < 0 {
+			next = position
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	next = append([]byte{}, next...)
+	for _, it := range positions.iterators {
+		if it.Valid() && bytes.Equal(keyLogPosition(it.Key()), next) {
+			it.Next()
+		}
+	}
+	return next
+}
+
+// Close closes the iterators of the prefixes.
+func (positions *logPositionIterator) Close() error {
+	var err error
+	for _, it := range positions.iterators {
+		if closeErr := it.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// keyLogPosition returns the (block number, log index) suffix of a log index
+// key.
+func keyLogPosition(key []byte) []byte {
+	return key[len(key)-logPositionLength:]
+}
+
+// firstTopicCriteria returns the first topic position that restricts the
+// topics, or -1 if all positions are wildcards.
+func firstTopicCriteria(topics [][]common.Hash) int {
+	for position, sub := range topics {
+		if len(sub) > 0 {
+			return position
+		}
+	}
+	return -1
+}
+
+// logMatches returns true if the log matches the address and topic criteria,
+// following the semantics of "eth_getLogs".
+func logMatches(log *gethcore.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if address == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PruneBlocks deletes the txs, address index entries and logs of the blocks
+// below "height", which caps the disk use of the indexer to a retention
+// window of recent blocks.
+func (indexer *EVMTxIndexer) PruneBlocks(height int64) error {
+	first, err := indexer.FirstIndexedBlock()
+	if err != nil {
+		return err
+	}
+	firstLog, lastLog, err := indexer.LogIndexRange()
+	if err != nil {
+		return err
+	}
+	if (first < 0 || first >= height) && (firstLog < 0 || firstLog >= height) {
+		return nil
+	}
+
+	batch := indexer.db.NewBatch()
+	defer batch.Close()
+
+	if err := indexer.deleteBlocks(batch, 0, height-1); err != nil {
+		return sdkioerrors.Wrapf(err, "PruneBlocks %d", height)
+	}
+	switch {
+	case firstLog < 0 || firstLog >= height:
+	case lastLog < height:
+		if err := batch.Delete([]byte{KeyPrefixLogIndexRange}); err != nil {
+			return sdkioerrors.Wrapf(err, "PruneBlocks %d, delete log index range", height)
+		}
+	default:
+		if err := setLogIndexRange(batch, height, lastLog); err != nil {
+			return sdkioerrors.Wrapf(err, "PruneBlocks %d", height)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return sdkioerrors.Wrapf(err, "PruneBlocks %d, write batch", height)
+	}
+	indexer.logger.Info("Pruned EVMTxIndexer DB", "below_height", height)
+	return nil
+}
+
+// VerifyBlock compares the eth txs indexed for a block with the eth txs of
+// the block. The counts differ for blocks that are missing from the index or
+// were partially indexed, which [EVMTxIndexer.ReindexBlock] repairs.
+func (indexer *EVMTxIndexer) VerifyBlock(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx,
+) (indexedTxs, expectedTxs int, err error) {
+	height := block.Header.Height
+	it, err := indexer.db.Iterator(TxIndexKey(height, 0), TxIndexKey(height+1, 0))
+	if err != nil {
+		return 0, 0, sdkioerrors.Wrapf(err, "VerifyBlock %d", height)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		indexedTxs++
+	}
+	return indexedTxs, len(indexer.blockEthTxs(block, txResults)), nil
+}
+
+// ReindexBlock deletes everything indexed for a block and indexes it again.
+func (indexer *EVMTxIndexer) ReindexBlock(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx,
+) error {
+	height := block.Header.Height
+	batch := indexer.db.NewBatch()
+	defer batch.Close()
+	if err := indexer.deleteBlocks(batch, height, height); err != nil {
+		return sdkioerrors.Wrapf(err, "ReindexBlock %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return sdkioerrors.Wrapf(err, "ReindexBlock %d, write batch", height)
+	}
+	return indexer.IndexBlock(block, txResults)
+}
+
+// deleteBlocks deletes the txs, address index entries and logs of the blocks
+// from "fromBlock" to "toBlock" in the kv db batch. It leaves the range of the
+// log index unchanged.
+func (indexer *EVMTxIndexer) deleteBlocks(batch dbm.Batch, fromBlock, toBlock int64) error {
+	start, end := TxIndexKey(fromBlock, 0), TxIndexKey(toBlock+1, 0)
+	if err := indexer.deleteRange(start, end, func(key, value []byte) error {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(value))); err != nil {
+			return sdkioerrors.Wrap(err, "delete tx hash key")
+		}
+		return batch.Delete(key)
+	}); err != nil {
+		return err
+	}
+
+	start, end = TxAddressesKey(fromBlock, 0), TxAddressesKey(toBlock+1, 0)
+	if err := indexer.deleteRange(start, end, func(key, value []byte) error {
+		return deleteTxAddresses(batch, key, value)
+	}); err != nil {
+		return err
+	}
+
+	start, end = LogKey(fromBlock, 0), LogKey(toBlock+1, 0)
+	return indexer.deleteRange(start, end, func(key, value []byte) error {
+		return deleteLog(indexer.clientCtx.Codec, batch, key, value)
+	})
+}
+
+// deleteRange calls "del" for every entry of the db in [start, end).
+func (indexer *EVMTxIndexer) deleteRange(
+	start, end []byte, del func(key, value []byte) error,
+) error {
+	it, err := indexer.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		// Copy the key and value, which are only valid until the next call to
+		// "Next".
+		key := append([]byte{}, it.Key()...)
+		value := append([]byte{}, it.Value()...)
+		if err := del(key, value); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	sdkioerrors "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// IndexerSink is a store that the [EVMTxIndexer] writes indexed blocks to.
+// The kv db of the indexer, which serves the JSON-RPC queries, is the default
+// sink. Extra sinks, like [SQLSink], export the indexed data to external
+// databases.
+type IndexerSink interface {
+	// WriteBlock writes the data of an indexed block. Writing a block again
+	// replaces its data, so that blocks can be re-indexed.
+	WriteBlock(block *IndexedBlock) error
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// IndexedBlock is the data that the [EVMTxIndexer] extracts from a block and
+// its tx results.
+type IndexedBlock struct {
+	Height int64
+	Hash   common.Hash
+	Time   time.Time
+	// Txs: Eth txs of the block, in order.
+	Txs []IndexedTx
+	// Logs: Logs emitted by the txs of the block, in order.
+	Logs []*gethcore.Log
+	// FunTokenConversions: Conversions between bank coins and ERC20 tokens of
+	// FunToken mappings made by the txs of the block, in order.
+	FunTokenConversions []FunTokenConversion
+}
+
+// IndexedTx is an eth tx of an [IndexedBlock] with its result.
+type IndexedTx struct {
+	Hash   common.Hash
+	Msg    *evm.MsgEthereumTx
+	Result eth.TxResult
+	// From: Sender of the tx, recovered from its signature.
+	From common.Address
+	// ContractAddress: Address of the contract created by a successful
+	// contract creation tx, nil otherwise.
+	ContractAddress *common.Address
+	// Recipients: Accounts that the tx sends to, as indexed by the address
+	// indexes.
+	Recipients []common.Address
+}
+
+// FunTokenConversion is a conversion of a FunToken mapping made by a tx of an
+// [IndexedBlock], either from bank coins to ERC20 tokens or back.
+type FunTokenConversion struct {
+	// TxHash: CometBFT hash of the tx.
+	TxHash common.Hash
+	// TxIndex: Index of the tx in the block.
+	TxIndex uint32
+	// EventIndex: Index of the conversion event in the tx result.
+	EventIndex uint32
+	// ToEvm: True for conversions of bank coins to ERC20 tokens, false for
+	// conversions of ERC20 tokens to bank coins.
+	ToEvm         bool
+	Sender        string
+	Erc20Contract common.Address
+	// Recipient: Eth address for conversions to ERC20 tokens and Bech32
+	// address for conversions to bank coins.
+	Recipient string
+	BankCoin  sdk.Coin
+}
+
+// indexedBlock extracts the data that the indexer writes to its sinks from a
+// block and its tx results.
+func (indexer *EVMTxIndexer) indexedBlock(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx,
+) (*IndexedBlock, error) {
+	height := block.Header.Height
+	indexedBlock := &IndexedBlock{
+		Height:              height,
+		Hash:                common.BytesToHash(block.Hash()),
+		Time:                block.Header.Time,
+		Txs:                 []IndexedTx{},
+		Logs:                []*gethcore.Log{},
+		FunTokenConversions: []FunTokenConversion{},
+	}
+
+	for _, ethTx := range indexer.blockEthTxs(block, txResults) {
+		txHash := common.HexToHash(ethTx.msg.Hash)
+		// The "From" field is cleared in valid txs, so the sender is recovered
+		// from the signature.
+		tx := ethTx.msg.AsTransaction()
+		sender, err := gethcore.LatestSignerForChainID(tx.ChainId()).Sender(tx)
+		if err != nil {
+			return nil, sdkioerrors.Wrapf(err, "recover sender of tx %s", txHash.Hex())
+		}
+		recipients := txRecipients(tx, sender, ethTx.result.Failed)
+		var contractAddr *common.Address
+		if tx.To() == nil && len(recipients) > 0 {
+			contractAddr = &recipients[0]
+		}
+		indexedBlock.Txs = append(indexedBlock.Txs, IndexedTx{
+			Hash:            txHash,
+			Msg:             ethTx.msg,
+			Result:          ethTx.result,
+			From:            sender,
+			ContractAddress: contractAddr,
+			Recipients:      recipients,
+		})
+	}
+
+	for txIndex, txResult := range txResults {
+		for eventIndex, event := range txResult.Events {
+			switch event.Type {
+			case evm.TypeUrlEventTxLog:
+				eventTxLog, err := evm.EventTxLogFromABCIEvent(event)
+				if err != nil {
+					indexer.logger.Error("Fail to parse tx logs", "err", err, "block", height, "txIndex", txIndex)
+					continue
+				}
+				indexedBlock.Logs = append(indexedBlock.Logs, evm.LogsToEthereum(eventTxLog.Logs)...)
+			case evm.TypeUrlEventConvertCoinToEvm, evm.TypeUrlEventConvertEvmToCoin:
+				if txResult.Code != abci.CodeTypeOK || txIndex >= len(block.Txs) {
+					continue
+				}
+				conversion, err := funTokenConversionFromABCIEvent(event)
+				if err != nil {
+					indexer.logger.Error("Fail to parse FunToken conversion", "err", err, "block", height, "txIndex", txIndex)
+					continue
+				}
+				conversion.TxHash = common.BytesToHash(block.Txs[txIndex].Hash())
+				conversion.TxIndex = uint32(txIndex)
+				conversion.EventIndex = uint32(eventIndex)
+				indexedBlock.FunTokenConversions = append(indexedBlock.FunTokenConversions, conversion)
+			}
+		}
+	}
+	return indexedBlock, nil
+}
+
+// funTokenConversionFromABCIEvent parses an [evm.EventConvertCoinToEvm] or an
+// [evm.EventConvertEvmToCoin].
+func funTokenConversionFromABCIEvent(event abci.Event) (FunTokenConversion, error) {
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return FunTokenConversion{}, sdkioerrors.Wrapf(
+			err, "failed to parse event of type %s", event.Type)
+	}
+	switch typedEvent := typedEvent.(type) {
+	case *evm.EventConvertCoinToEvm:
+		return FunTokenConversion{
+			ToEvm:         true,
+			Sender:        typedEvent.Sender,
+			Erc20Contract: common.HexToAddress(typedEvent.Erc20ContractAddress),
+			Recipient:     typedEvent.ToEthAddr,
+			BankCoin:      typedEvent.BankCoin,
+		}, nil
+	case *evm.EventConvertEvmToCoin:
+		return FunTokenConversion{
+			ToEvm:         false,
+			Sender:        typedEvent.Sender,
+			Erc20Contract: common.HexToAddress(typedEvent.Erc20ContractAddress),
+			Recipient:     typedEvent.ToBankAddr,
+			BankCoin:      typedEvent.BankCoin,
+		}, nil
+	default:
+		return FunTokenConversion{}, fmt.Errorf("unexpected event of type %s", event.Type)
+	}
+}
+
+// kvSink is the default [IndexerSink], which writes to the kv db that the
+// queries of the [EVMTxIndexer] read.
+type kvSink struct {
+	indexer *EVMTxIndexer
+}
+
+var _ IndexerSink = kvSink{}
+
+// WriteBlock stores the txs of the block with their results, adds them to
+// the address indexes and adds the logs of the block to the log index.
+func (sink kvSink) WriteBlock(block *IndexedBlock) error {
+	batch := sink.indexer.db.NewBatch()
+	defer batch.Close()
+
+	codec := sink.indexer.clientCtx.Codec
+	for i := range block.Txs {
+		tx := &block.Txs[i]
+		if err := saveTxResult(codec, batch, tx.Hash, &tx.Result); err != nil {
+			return err
+		}
+		if err := saveTxAddresses(batch, tx); err != nil {
+			return err
+		}
+	}
+	if err := sink.indexer.indexBlockLogs(batch, block.Height, block.Logs); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return sdkioerrors.Wrap(err, "write batch")
+	}
+	return nil
+}
+
+// Close closes the kv db.
+func (sink kvSink) Close() error {
+	return sink.indexer.db.Close()
+}
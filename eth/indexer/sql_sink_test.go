@@ -0,0 +1,220 @@
+package indexer_test
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth/indexer"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+func TestSQLSink(t *testing.T) {
+	txSigner := newTestEthTxSigner(t)
+	sender := txSigner.sender
+	recipient := common.BigToAddress(big.NewInt(1))
+	erc20 := common.BigToAddress(big.NewInt(2))
+	topic := common.BigToHash(big.NewInt(3))
+
+	transferHash, transferBz, transferRes := txSigner.ethTx(evm.EvmTxArgs{
+		Nonce: 0, To: &recipient, Amount: big.NewInt(1000), GasLimit: 21000,
+	}, 0)
+	transferRes.Events = append(transferRes.Events, typedEvent(t, &evm.EventTxLog{Logs: []evm.Log{
+		evm.NewLogFromEth(&gethcore.Log{
+			Address: erc20, Topics: []common.Hash{topic}, Data: []byte{1},
+			BlockNumber: 1, TxHash: transferHash, Index: 0,
+		}),
+	}}))
+	createHash, createBz, createRes := txSigner.ethTx(evm.EvmTxArgs{
+		Nonce: 1, Amount: big.NewInt(0), GasLimit: 100_000, Input: []byte{0x00},
+	}, 1)
+	convertBz := cmttypes.Tx("convert coin to evm")
+	convertRes := &abci.ResponseDeliverTx{Code: 0, Events: []abci.Event{
+		typedEvent(t, &evm.EventConvertCoinToEvm{
+			Sender:               "nibi1sender",
+			Erc20ContractAddress: erc20.Hex(),
+			ToEthAddr:            recipient.Hex(),
+			BankCoin:             sdk.NewInt64Coin("unibi", 42),
+		}),
+	}}
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{Height: 1, Time: time.Unix(1_700_000_000, 0)},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{transferBz, createBz, convertBz}},
+	}
+	results := []*abci.ResponseDeliverTx{transferRes, createRes, convertRes}
+
+	sink, err := indexer.NewSQLSink(indexer.SQLDriverSQLite, filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+	require.NoError(t, err)
+	idxer := indexer.NewEVMTxIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), txSigner.clientCtx, sink)
+	defer func() { require.NoError(t, idxer.CloseDBAndExit()) }()
+	require.NoError(t, idxer.IndexBlock(block, results))
+
+	db := sink.DB()
+	t.Run("blocks", func(t *testing.T) {
+		var height, blockTime int64
+		var txCount int
+		require.NoError(t, db.QueryRow(
+			`SELECT height, block_time, tx_count FROM evm_blocks`,
+		).Scan(&height, &blockTime, &txCount))
+		require.Equal(t, int64(1), height)
+		require.Equal(t, int64(1_700_000_000), blockTime)
+		require.Equal(t, 2, txCount)
+	})
+
+	t.Run("txs and receipts", func(t *testing.T) {
+		rows, err := db.Query(
+			`SELECT t.hash, t.tx_index, t.from_address, t.to_address, t.value,
+				r.status, r.gas_used, r.contract_address
+			FROM evm_txs t JOIN evm_receipts r ON r.tx_hash = t.hash
+			ORDER BY t.height, t.tx_index`,
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+		type txRow struct {
+			hash, from, value string
+			txIndex, status   int
+			gasUsed           int64
+			to, contract      *string
+		}
+		got := []txRow{}
+		for rows.Next() {
+			var row txRow
+			require.NoError(t, rows.Scan(
+				&row.hash, &row.txIndex, &row.from, &row.to, &row.value,
+				&row.status, &row.gasUsed, &row.contract,
+			))
+			got = append(got, row)
+		}
+		require.NoError(t, rows.Err())
+		require.Len(t, got, 2)
+
+		senderHex := hexAddr(sender)
+		recipientHex := hexAddr(recipient)
+		contractHex := hexAddr(crypto.CreateAddress(sender, 1))
+		require.Equal(t, txRow{
+			hash: transferHash.Hex(), txIndex: 0, from: senderHex, to: &recipientHex,
+			value: "1000", status: 1,
+		}, got[0])
+		require.Equal(t, txRow{
+			hash: createHash.Hex(), txIndex: 1, from: senderHex,
+			value: "0", status: 1, contract: &contractHex,
+		}, got[1])
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		var txHash, address, topic0, data string
+		var topic1 *string
+		require.NoError(t, db.QueryRow(
+			`SELECT tx_hash, address, topic0, topic1, data FROM evm_logs WHERE height = 1 AND log_index = 0`,
+		).Scan(&txHash, &address, &topic0, &topic1, &data))
+		require.Equal(t, transferHash.Hex(), txHash)
+		require.Equal(t, hexAddr(erc20), address)
+		require.Equal(t, topic.Hex(), topic0)
+		require.Nil(t, topic1)
+		require.Equal(t, "0x01", data)
+	})
+
+	t.Run("FunToken conversions", func(t *testing.T) {
+		var txHash, direction, erc20Addr, recipientAddr, denom, amount string
+		var txIndex int
+		require.NoError(t, db.QueryRow(
+			`SELECT tx_hash, tx_index, direction, erc20_address, recipient, bank_denom, amount
+			FROM evm_funtoken_conversions`,
+		).Scan(&txHash, &txIndex, &direction, &erc20Addr, &recipientAddr, &denom, &amount))
+		require.Equal(t, common.BytesToHash(convertBz.Hash()).Hex(), txHash)
+		require.Equal(t, 2, txIndex)
+		require.Equal(t, "coin_to_evm", direction)
+		require.Equal(t, hexAddr(erc20), erc20Addr)
+		require.Equal(t, recipient.Hex(), recipientAddr)
+		require.Equal(t, "unibi", denom)
+		require.Equal(t, "42", amount)
+	})
+
+	t.Run("reindexing a block replaces its rows", func(t *testing.T) {
+		require.NoError(t, idxer.ReindexBlock(block, results))
+		for table, expCount := range map[string]int{
+			"evm_blocks":               1,
+			"evm_txs":                  2,
+			"evm_receipts":             2,
+			"evm_logs":                 1,
+			"evm_funtoken_conversions": 1,
+		} {
+			var count int
+			require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM `+table).Scan(&count))
+			require.Equal(t, expCount, count, table)
+		}
+	})
+
+	t.Run("kv db is still written", func(t *testing.T) {
+		txResult, err := idxer.GetByTxHash(createHash)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), txResult.EthTxIndex)
+	})
+}
+
+func typedEvent(t *testing.T, event proto.Message) abci.Event {
+	sdkEvent, err := sdk.TypedEventToEvent(event)
+	require.NoError(t, err)
+	return abci.Event(sdkEvent)
+}
+
+func hexAddr(address common.Address) string {
+	return "0x" + common.Bytes2Hex(address.Bytes())
+}
+
+// failingSink is an [indexer.IndexerSink] that fails to write blocks while
+// "fail" is set.
+type failingSink struct {
+	fail bool
+}
+
+func (sink *failingSink) WriteBlock(*indexer.IndexedBlock) error {
+	if sink.fail {
+		return errors.New("sink unavailable")
+	}
+	return nil
+}
+
+func (sink *failingSink) Close() error { return nil }
+
+func TestIndexBlockSinkError(t *testing.T) {
+	txSigner := newTestEthTxSigner(t)
+	recipient := common.BigToAddress(big.NewInt(1))
+	txHash, txBz, txRes := txSigner.ethTx(evm.EvmTxArgs{
+		Nonce: 0, To: &recipient, Amount: big.NewInt(1000), GasLimit: 21000,
+	}, 0)
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{Height: 1},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+	}
+	results := []*abci.ResponseDeliverTx{txRes}
+
+	sink := &failingSink{fail: true}
+	idxer := indexer.NewEVMTxIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), txSigner.clientCtx, sink)
+	require.ErrorContains(t, idxer.IndexBlock(block, results), "sink unavailable")
+	lastBlock, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), lastBlock, "block written to the kv db without the sink")
+	_, err = idxer.GetByTxHash(txHash)
+	require.Error(t, err)
+
+	sink.fail = false
+	require.NoError(t, idxer.IndexBlock(block, results))
+	lastBlock, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), lastBlock)
+}
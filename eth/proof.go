@@ -0,0 +1,93 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package eth
+
+import (
+	"bytes"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StoreProof is an ICS23 proof that a key of a Cosmos SDK module store has a
+// value, or does not exist, in the state committed to by an app hash. Nibiru
+// has no Merkle-Patricia trie, so "eth_getProof" proves EVM state with these
+// proofs instead.
+//
+// The proof has two steps, in order:
+//  1. An IAVL proof ("ics23:iavl") of the key in the module store, which
+//     gives the root hash of the module store.
+//  2. A simple Merkle proof ("ics23:simple") of the module store root in the
+//     multistore, which gives the app hash.
+type StoreProof struct {
+	// StoreKey: Name of the module store, like "evm" or "bank".
+	StoreKey string `json:"storeKey"`
+	// Key: Key in the module store.
+	Key hexutil.Bytes `json:"key"`
+	// Value: Value stored under the key. It is empty if the key does not exist,
+	// in which case the proof is a proof of absence.
+	Value hexutil.Bytes `json:"value"`
+	// Proof: ICS23 commitment proofs of each step, encoded as protobuf.
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// NewStoreProof builds a [StoreProof] from the result of an ABCI store query
+// made with "Prove: true".
+func NewStoreProof(
+	storeKey string, key, value []byte, proofOps *cmtcrypto.ProofOps,
+) StoreProof {
+	proof := StoreProof{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    value,
+		Proof:    []hexutil.Bytes{},
+	}
+	if proofOps != nil {
+		for _, op := range proofOps.Ops {
+			proof.Proof = append(proof.Proof, op.Data)
+		}
+	}
+	return proof
+}
+
+// Verify checks the proof against an app hash. It returns nil if the key has
+// the value of the proof, or does not exist for an empty value, in the state
+// committed to by the app hash.
+//
+// The app hash of a block commits to the state after its parent block, so
+// proofs of the state at block "n", as returned by "eth_getProof" for block
+// "n", are verified with the app hash in the header of block "n + 1".
+func (p StoreProof) Verify(appHash []byte) error {
+	if len(p.Proof) != 2 {
+		return fmt.Errorf(
+			"store proof must have 2 steps (iavl and simple), got %d", len(p.Proof))
+	}
+
+	ops := []cmtcrypto.ProofOp{
+		{Type: storetypes.ProofOpIAVLCommitment, Key: p.Key, Data: p.Proof[0]},
+		{Type: storetypes.ProofOpSimpleMerkleCommitment, Key: []byte(p.StoreKey), Data: p.Proof[1]},
+	}
+
+	// An empty list of values makes the IAVL step check for absence.
+	args := [][]byte{}
+	if len(p.Value) > 0 {
+		args = [][]byte{p.Value}
+	}
+	for _, op := range ops {
+		operator, err := storetypes.CommitmentOpDecoder(op)
+		if err != nil {
+			return fmt.Errorf("invalid %s proof: %w", op.Type, err)
+		}
+		args, err = operator.Run(args)
+		if err != nil {
+			return fmt.Errorf("failed to verify %s proof: %w", op.Type, err)
+		}
+	}
+
+	if !bytes.Equal(args[0], appHash) {
+		return fmt.Errorf(
+			"store proof root %X does not match app hash %X", args[0], appHash)
+	}
+	return nil
+}
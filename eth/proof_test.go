@@ -0,0 +1,84 @@
+package eth_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+func TestStoreProof(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	evmStoreKey := storetypes.NewKVStoreKey("evm")
+	bankStoreKey := storetypes.NewKVStoreKey("bank")
+	store.MountStoreWithDB(evmStoreKey, storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	evmStore := store.GetCommitStore(evmStoreKey).(*iavl.Store)
+	evmStore.Set([]byte("key-a"), []byte("value-a"))
+	evmStore.Set([]byte("key-c"), []byte("value-c"))
+	store.GetCommitStore(bankStoreKey).(*iavl.Store).Set([]byte("key-a"), []byte("bank"))
+	appHash := store.Commit().Hash
+
+	queryProof := func(storeKey string, key []byte) eth.StoreProof {
+		res := store.Query(abci.RequestQuery{
+			Path:  "/" + storeKey + "/key",
+			Data:  key,
+			Prove: true,
+		})
+		require.Zero(t, res.Code, res.Log)
+		return eth.NewStoreProof(storeKey, key, res.Value, res.ProofOps)
+	}
+
+	t.Run("existence proof", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-a"))
+		require.Equal(t, hexutil.Bytes("value-a"), proof.Value)
+		require.NoError(t, proof.Verify(appHash))
+	})
+
+	t.Run("absence proof", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-b"))
+		require.Empty(t, proof.Value)
+		require.NoError(t, proof.Verify(appHash))
+	})
+
+	t.Run("wrong value", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-a"))
+		proof.Value = []byte("value-b")
+		require.ErrorContains(t, proof.Verify(appHash), "failed to verify")
+	})
+
+	t.Run("value claimed for an absent key", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-b"))
+		proof.Value = []byte("value-b")
+		require.Error(t, proof.Verify(appHash))
+	})
+
+	t.Run("wrong store", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-a"))
+		proof.StoreKey = "bank"
+		require.Error(t, proof.Verify(appHash))
+	})
+
+	t.Run("wrong app hash", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-a"))
+		wrongHash := append([]byte{}, appHash...)
+		wrongHash[0] ^= 0xff
+		require.ErrorContains(t, proof.Verify(wrongHash), "does not match app hash")
+	})
+
+	t.Run("missing proof step", func(t *testing.T) {
+		proof := queryProof("evm", []byte("key-a"))
+		proof.Proof = proof.Proof[:1]
+		require.ErrorContains(t, proof.Verify(appHash), "must have 2 steps")
+	})
+}
@@ -8,13 +8,17 @@ import (
 
 	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	pkgerrors "github.com/pkg/errors"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
@@ -40,7 +44,13 @@ func (b *Backend) GetCode(
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs.
+//
+// Besides the fields of "eth_getProof" in Ethereum, the result has ICS23
+// proofs of the account in the "x/auth" store, of its balance in the "x/bank"
+// store, of its code and of each storage slot in the "x/evm" store. These are
+// verified with [eth.StoreProof.Verify] against the app hash of the block after
+// the requested one.
 func (b *Backend) GetProof(
 	address gethcommon.Address,
 	storageKeys []string,
@@ -82,7 +92,7 @@ func (b *Backend) GetProof(
 
 	for i, key := range storageKeys {
 		hexKey := gethcommon.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(
+		storeProof, proof, err := b.queryStoreProof(
 			clientCtx,
 			evm.StoreKey,
 			evm.StateKey(address, hexKey.Bytes()),
@@ -92,9 +102,10 @@ func (b *Backend) GetProof(
 		}
 
 		storageProofs[i] = rpc.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+			Key:        key,
+			Value:      (*hexutil.Big)(new(big.Int).SetBytes(storeProof.Value)),
+			Proof:      GetHexProofs(proof),
+			StoreProof: storeProof,
 		}
 	}
 
@@ -110,11 +121,29 @@ func (b *Backend) GetProof(
 
 	// query account proofs
 	accountKey := authtypes.AddressStoreKey(address.Bytes())
-	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	authAccountProof, proof, err := b.queryStoreProof(clientCtx, authtypes.StoreKey, accountKey)
 	if err != nil {
 		return nil, err
 	}
 
+	balanceKey := append(
+		banktypes.CreateAccountBalancesPrefix(address.Bytes()), []byte(evm.EVMBankDenom)...,
+	)
+	balanceProof, _, err := b.queryStoreProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := gethcommon.HexToHash(res.CodeHash)
+	var codeProof *eth.StoreProof
+	if codeHash != (gethcommon.Hash{}) && codeHash != gethcommon.BytesToHash(evm.EmptyCodeHash) {
+		storeProof, _, err := b.queryStoreProof(clientCtx, evm.StoreKey, evm.CodeKey(codeHash.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		codeProof = &storeProof
+	}
+
 	balance, ok := sdkmath.NewIntFromString(res.BalanceWei)
 	if !ok {
 		return nil, pkgerrors.New("invalid balance")
@@ -124,16 +153,33 @@ func (b *Backend) GetProof(
 		Address:      address,
 		AccountProof: GetHexProofs(proof),
 		Balance:      (*hexutil.Big)(balance.BigInt()),
-		CodeHash:     gethcommon.HexToHash(res.CodeHash),
+		CodeHash:     codeHash,
 		Nonce:        hexutil.Uint64(res.Nonce),
 		// NOTE: The StorageHash is blank. Consider whether this is useful in the
 		// future. Currently, all storage is handles by persistent and transient
 		// `sdk.KVStore` objects.
 		StorageHash:  gethcommon.Hash{},
 		StorageProof: storageProofs,
+
+		AppHashBlockNumber: hexutil.Uint64(height + 1), // #nosec G115 -- height is positive
+		AuthAccountProof:   authAccountProof,
+		BalanceProof:       balanceProof,
+		CodeProof:          codeProof,
 	}, nil
 }
 
+// queryStoreProof queries the value of a key in a module store together with
+// its ICS23 proof at the height of the client context.
+func (b *Backend) queryStoreProof(
+	clientCtx client.Context, storeKey string, key []byte,
+) (eth.StoreProof, *cmtcrypto.ProofOps, error) {
+	value, proofOps, err := b.queryClient.GetProof(clientCtx, storeKey, key)
+	if err != nil {
+		return eth.StoreProof{}, nil, err
+	}
+	return eth.NewStoreProof(storeKey, key, value, proofOps), proofOps, nil
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address gethcommon.Address, key string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -156,6 +202,8 @@ func (b *Backend) GetStorageAt(address gethcommon.Address, key string, blockNrOr
 }
 
 // GetBalance returns the provided account's balance up to the provided block number.
+// The "pending" block tag reads the balance on the pending state, which
+// accounts for the mempool txs. See [Backend.PendingStateTxs].
 func (b *Backend) GetBalance(
 	address gethcommon.Address,
 	blockNrOrHash rpc.BlockNumberOrHash,
@@ -174,14 +222,24 @@ func (b *Backend) GetBalance(
 		return nil, err
 	}
 
-	res, err := b.queryClient.Balance(rpc.NewContextWithHeight(blockNum.Int64()), req)
-	if err != nil {
-		return nil, err
+	var balanceWei string
+	if blockNum == rpc.EthPendingBlockNumber {
+		res, err := b.PendingAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		balanceWei = res.BalanceWei
+	} else {
+		res, err := b.queryClient.Balance(rpc.NewContextWithHeight(blockNum.Int64()), req)
+		if err != nil {
+			return nil, err
+		}
+		balanceWei = res.BalanceWei
 	}
 
-	val, ok := sdkmath.NewIntFromString(res.BalanceWei)
+	val, ok := sdkmath.NewIntFromString(balanceWei)
 	if !ok {
-		return nil, fmt.Errorf("invalid balance: %s", res.BalanceWei)
+		return nil, fmt.Errorf("invalid balance: %s", balanceWei)
 	}
 
 	// balance can only be negative in case of pruned node
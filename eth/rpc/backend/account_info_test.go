@@ -8,6 +8,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	rpc "github.com/NibiruChain/nibiru/v2/eth/rpc"
 )
 
@@ -57,6 +58,7 @@ func (s *BackendSuite) TestGetProof() {
 		address      gethcommon.Address
 		slot         uint64
 		wantValue    string
+		wantCode     bool
 	}{
 		{
 			name:         "happy: balance of the contract deployer",
@@ -65,6 +67,7 @@ func (s *BackendSuite) TestGetProof() {
 			blockNumber:  *s.SuccessfulTxDeployContract().BlockNumberRpc,
 			slot:         0,                        // _balances is the first slot in ERC20
 			wantValue:    "0xd3c21bcecceda1000000", // = 1000000 * (10**18), initial supply
+			wantCode:     true,
 		},
 		{
 			name:         "sad: address which is not in contract storage",
@@ -73,6 +76,7 @@ func (s *BackendSuite) TestGetProof() {
 			blockNumber:  *s.SuccessfulTxDeployContract().BlockNumberRpc,
 			slot:         0,
 			wantValue:    "0x0",
+			wantCode:     false,
 		},
 	}
 	for _, tc := range testCases {
@@ -87,6 +91,32 @@ func (s *BackendSuite) TestGetProof() {
 			s.Require().NoError(err)
 			s.Require().NotNil(proof)
 			s.Require().Equal(tc.wantValue, proof.StorageProof[0].Value.String())
+
+			s.T().Log("Expect ICS23 proofs to verify against the app hash of the next block")
+			s.Require().EqualValues(tc.blockNumber+1, proof.AppHashBlockNumber)
+			_, err = s.network.WaitForHeight(int64(proof.AppHashBlockNumber))
+			s.Require().NoError(err)
+			nextBlock, err := s.backend.TendermintBlockByNumber(rpc.BlockNumber(proof.AppHashBlockNumber))
+			s.Require().NoError(err)
+			appHash := nextBlock.Block.AppHash
+
+			storeProofs := []eth.StoreProof{
+				proof.AuthAccountProof,
+				proof.BalanceProof,
+				proof.StorageProof[0].StoreProof,
+			}
+			s.Require().Equal(tc.wantCode, proof.CodeProof != nil)
+			if tc.wantCode {
+				storeProofs = append(storeProofs, *proof.CodeProof)
+			}
+			for _, storeProof := range storeProofs {
+				s.NoError(storeProof.Verify(appHash), "store %s, key %s", storeProof.StoreKey, storeProof.Key)
+			}
+
+			s.T().Log("Expect proofs to fail against the app hash of another block")
+			otherBlock, err := s.backend.TendermintBlockByNumber(tc.blockNumber - 1)
+			s.Require().NoError(err)
+			s.Error(proof.AuthAccountProof.Verify(otherBlock.Block.AppHash))
 		})
 	}
 }
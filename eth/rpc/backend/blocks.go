@@ -327,7 +327,7 @@ func (b *Backend) HeaderByNumber(blockNum rpc.BlockNumber) (*gethcore.Header, er
 	}
 
 	bloom := b.BlockBloom(blockRes)
-	baseFeeWei := evm.BASE_FEE_WEI
+	baseFeeWei := b.blockBaseFeeWei(resBlock.Block.Height)
 
 	ethHeader := rpc.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFeeWei)
 	return ethHeader, nil
@@ -363,7 +363,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 ) (map[string]any, error) {
 	ethRPCTxs := []any{}
 	block := resBlock.Block
-	baseFeeWei := evm.BASE_FEE_WEI
+	baseFeeWei := b.blockBaseFeeWei(block.Height)
 
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 	for txIndex, ethMsg := range msgs {
@@ -469,7 +469,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 ) (*gethcore.Block, error) {
 	block := resBlock.Block
 	bloom := b.BlockBloom(blockRes)
-	baseFeeWei := evm.BASE_FEE_WEI
+	baseFeeWei := b.blockBaseFeeWei(block.Height)
 
 	ethHeader := rpc.EthHeaderFromTendermint(block.Header, bloom, baseFeeWei)
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
@@ -197,7 +197,7 @@ func (b *Backend) SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
 		}
 
 		blockNr := rpc.EthPendingBlockNumber
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -213,8 +213,13 @@ func (b *Backend) SetTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
+// The optional state and block overrides are applied before simulating the
+// call. See [evm.StateOverride] and [evm.BlockOverrides].
 func (b *Backend) EstimateGas(
-	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+	args evm.JsonTxArgs,
+	blockNrOptional *rpc.BlockNumber,
+	stateOverrides *rpc.StateOverride,
+	blockOverrides *evm.BlockOverrides,
 ) (hexutil.Uint64, error) {
 	blockNr := rpc.EthPendingBlockNumber
 	if blockNrOptional != nil {
@@ -238,6 +243,12 @@ func (b *Backend) EstimateGas(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if err := setEthCallOverrides(&req, stateOverrides, blockOverrides); err != nil {
+		return 0, err
+	}
+	if err := b.setEthCallPendingTxs(&req, blockNr); err != nil {
+		return 0, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -250,9 +261,13 @@ func (b *Backend) EstimateGas(
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// and block overrides are applied before executing the call.
 func (b *Backend) DoCall(
-	args evm.JsonTxArgs, blockNr rpc.BlockNumber,
+	args evm.JsonTxArgs,
+	blockNr rpc.BlockNumber,
+	stateOverrides *rpc.StateOverride,
+	blockOverrides *evm.BlockOverrides,
 ) (*evm.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -270,6 +285,12 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if err := setEthCallOverrides(&req, stateOverrides, blockOverrides); err != nil {
+		return nil, err
+	}
+	if err := b.setEthCallPendingTxs(&req, blockNr); err != nil {
+		return nil, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -305,6 +326,67 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes the calls of "eth_simulateV1" on top of the state at the
+// given block. See [evm.SimOpts].
+func (b *Backend) SimulateV1(
+	opts evm.SimOpts, blockNr rpc.BlockNumber,
+) (*evm.SimulateV1Response, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, pkgerrors.New("header not found")
+	}
+
+	req := evm.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	ctx := rpc.NewContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	return b.queryClient.SimulateV1(ctx, &req)
+}
+
+// setEthCallOverrides JSON-encodes the optional state and block overrides into
+// the [evm.EthCallRequest].
+func setEthCallOverrides(
+	req *evm.EthCallRequest,
+	stateOverrides *rpc.StateOverride,
+	blockOverrides *evm.BlockOverrides,
+) (err error) {
+	if stateOverrides != nil {
+		if err := stateOverrides.Validate(); err != nil {
+			return err
+		}
+		if req.StateOverrides, err = json.Marshal(stateOverrides); err != nil {
+			return err
+		}
+	}
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -316,13 +398,19 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Txs sent now go into the next block, whose base fee is the one stored in
+	// the state of the latest block rather than the base fee of that block.
+	baseFeeWei, err := b.NextBaseFeeWei(head.Number.Int64())
+	if err != nil {
+		return nil, err
+	}
 
-	if head.BaseFee != nil {
-		result, err = b.SuggestGasTipCap(head.BaseFee)
+	if baseFeeWei != nil {
+		result, err = b.SuggestGasTipCap(baseFeeWei)
 		if err != nil {
 			return nil, err
 		}
-		result = result.Add(result, head.BaseFee)
+		result = result.Add(result, baseFeeWei)
 	} else {
 		result = big.NewInt(b.RPCMinGasPrice())
 	}
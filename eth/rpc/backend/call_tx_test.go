@@ -76,7 +76,7 @@ func (s *BackendSuite) TestDoCall() {
 		To:    &recipient,
 		Value: (*hexutil.Big)(evm.NativeToWei(big.NewInt(1))),
 	}
-	txResponse, err := s.backend.DoCall(jsonTxArgs, rpc.EthPendingBlockNumber)
+	txResponse, err := s.backend.DoCall(jsonTxArgs, rpc.EthPendingBlockNumber, nil, nil)
 	s.Require().NoError(err)
 	s.Require().NotNil(txResponse)
 	s.Require().Greater(txResponse.GasUsed, uint64(0))
@@ -27,25 +27,49 @@ func (b *Backend) ChainID() *hexutil.Big {
 
 // ChainConfig returns the latest ethereum chain configuration
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	_, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
+	res, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
 	if err != nil {
 		return nil
 	}
-	return evm.EthereumConfig(b.chainID)
+	return res.Params.EthereumConfig(b.chainID)
 }
 
-// BaseFeeWei returns the EIP-1559 base fee.
-// If the base fee is not enabled globally, the query returns nil.
+// BaseFeeWei returns the EIP-1559 base fee that applied to the transactions
+// of the given block.
+//
+// The EVM module sets the base fee of the next block at the end of each block,
+// so the base fee of block "h" is read from the committed state at "h - 1".
 func (b *Backend) BaseFeeWei(
 	blockRes *tmrpctypes.ResultBlockResults,
 ) (baseFeeWei *big.Int, err error) {
-	res, err := b.queryClient.BaseFee(rpc.NewContextWithHeight(blockRes.Height), &evm.QueryBaseFeeRequest{})
+	if blockRes.Height <= 1 {
+		return evm.BASE_FEE_WEI, nil
+	}
+	return b.NextBaseFeeWei(blockRes.Height - 1)
+}
+
+// NextBaseFeeWei returns the EIP-1559 base fee of the block after the given
+// height, which is the base fee stored in the committed state at that height.
+func (b *Backend) NextBaseFeeWei(height int64) (baseFeeWei *big.Int, err error) {
+	res, err := b.queryClient.BaseFee(rpc.NewContextWithHeight(height), &evm.QueryBaseFeeRequest{})
 	if err != nil || res.BaseFee == nil {
 		return nil, pkgerrors.Wrap(err, "failed to query base fee")
 	}
 	return res.BaseFee.BigInt(), nil
 }
 
+// blockBaseFeeWei is [Backend.BaseFeeWei] for a block height. It falls back to
+// the initial base fee, [evm.BASE_FEE_WEI], if the state of the height is not
+// available, for example because it was pruned.
+func (b *Backend) blockBaseFeeWei(height int64) *big.Int {
+	baseFeeWei, err := b.BaseFeeWei(&tmrpctypes.ResultBlockResults{Height: height})
+	if err != nil {
+		b.logger.Debug("failed to query base fee", "height", height, "error", err.Error())
+		return evm.BASE_FEE_WEI
+	}
+	return baseFeeWei
+}
+
 // CurrentHeader returns the latest block header
 // This will return error as per node configuration
 // if the ABCI responses are discarded ('discard_abci_responses' config param)
@@ -0,0 +1,239 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// traceConfigFlatCalls returns the trace config used by the "trace" namespace:
+// geth's native "callTracer" with the full tree of nested call frames.
+func traceConfigFlatCalls() *evm.TraceConfig {
+	return &evm.TraceConfig{
+		Tracer:       "callTracer",
+		TracerConfig: &evm.TracerConfig{OnlyTopCall: false},
+	}
+}
+
+// TraceBlockFlat returns the Parity flat traces of all EVM transactions in the
+// block at the given height. It implements "trace_block".
+func (b *Backend) TraceBlockFlat(height rpc.BlockNumber) ([]rpc.ParityTrace, error) {
+	resBlock, err := b.TendermintBlockByNumber(height)
+	if err != nil {
+		b.logger.Debug("block not found", "height", height)
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, fmt.Errorf("genesis is not traceable")
+	}
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", resBlock.Block.Height)
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	traces := []rpc.ParityTrace{}
+	if len(msgs) == 0 {
+		return traces, nil
+	}
+
+	results, err := b.traceBlockMsgs(
+		rpc.BlockNumber(resBlock.Block.Height), traceConfigFlatCalls(), resBlock, msgs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf(
+			"traced %d of the %d transactions in block %d",
+			len(results), len(msgs), resBlock.Block.Height,
+		)
+	}
+
+	blockHash := gethcommon.BytesToHash(resBlock.Block.Header.Hash())
+	blockNumber := uint64(resBlock.Block.Height) // #nosec G115 -- height is positive
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %s: %s", msgs[i].Hash, result.Error)
+		}
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		txHash := gethcommon.HexToHash(msgs[i].Hash)
+		txPosition := uint64(i)
+		traces = append(traces, rpc.FlattenCallFrame(frame, rpc.ParityTraceTxInfo{
+			BlockHash:           &blockHash,
+			BlockNumber:         &blockNumber,
+			TransactionHash:     &txHash,
+			TransactionPosition: &txPosition,
+		})...)
+	}
+	return traces, nil
+}
+
+// TraceTransactionFlat returns the Parity flat traces of the transaction with
+// the given hash. It implements "trace_transaction".
+func (b *Backend) TraceTransactionFlat(txHash gethcommon.Hash) ([]rpc.ParityTrace, error) {
+	frame, err := b.traceTransactionCallFrame(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txInfo, err := b.parityTraceTxInfo(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.FlattenCallFrame(frame, txInfo), nil
+}
+
+// TraceReplayTransaction replays the transaction with the given hash and
+// returns its output and Parity flat traces. It implements
+// "trace_replayTransaction".
+func (b *Backend) TraceReplayTransaction(txHash gethcommon.Hash) (*rpc.ParityTraceResults, error) {
+	frame, err := b.traceTransactionCallFrame(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ParityTraceResults{
+		Output: frame.Output,
+		Trace:  rpc.FlattenCallFrame(frame, rpc.ParityTraceTxInfo{}),
+	}, nil
+}
+
+// TraceCallFlat executes a call on top of the state of the given block and
+// returns its output and Parity flat traces. It implements "trace_call".
+func (b *Backend) TraceCallFlat(
+	txArgs evm.JsonTxArgs, blockNr rpc.BlockNumber,
+) (*rpc.ParityTraceResults, error) {
+	res, err := b.TraceCall(txArgs, blockNr, traceConfigFlatCalls())
+	if err != nil {
+		return nil, err
+	}
+	var frame rpc.CallFrame
+	if err := json.Unmarshal(res, &frame); err != nil {
+		return nil, fmt.Errorf("failed to decode call trace: %w", err)
+	}
+	return &rpc.ParityTraceResults{
+		Output: frame.Output,
+		Trace:  rpc.FlattenCallFrame(frame, rpc.ParityTraceTxInfo{}),
+	}, nil
+}
+
+// TraceFilter returns the Parity flat traces in a block range that match the
+// address filters of the given arguments. It implements "trace_filter". The
+// block range may not exceed the "BlockRangeCap" of the JSON-RPC config.
+func (b *Backend) TraceFilter(args rpc.TraceFilterArgs) ([]rpc.ParityTrace, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := int64(head), int64(head) // #nosec G115 -- checked by BlockNumber
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if blockLimit := int64(b.RPCBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+	from = max(from, 1) // genesis is not traceable
+	to = min(to, int64(head))
+	if from > to {
+		return []rpc.ParityTrace{}, nil
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = uint64(*args.After)
+	}
+	if args.Count != nil {
+		count = uint64(*args.Count)
+		if count == 0 {
+			return []rpc.ParityTrace{}, nil
+		}
+	}
+
+	traces := []rpc.ParityTrace{}
+	for height := from; height <= to; height++ {
+		blockTraces, err := b.TraceBlockFlat(rpc.BlockNumber(height))
+		if err != nil {
+			return nil, fmt.Errorf("failed to trace block %d: %w", height, err)
+		}
+		for _, trace := range blockTraces {
+			if !trace.Matches(args.FromAddress, args.ToAddress) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) == count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// traceTransactionCallFrame traces the transaction with the given hash using
+// the "callTracer" and returns its top-level call frame.
+func (b *Backend) traceTransactionCallFrame(txHash gethcommon.Hash) (rpc.CallFrame, error) {
+	res, err := b.TraceTransaction(txHash, traceConfigFlatCalls())
+	if err != nil {
+		return rpc.CallFrame{}, err
+	}
+	var frame rpc.CallFrame
+	if err := json.Unmarshal(res, &frame); err != nil {
+		return rpc.CallFrame{}, fmt.Errorf("failed to decode call trace: %w", err)
+	}
+	return frame, nil
+}
+
+// parityTraceTxInfo returns the block and position of the transaction with
+// the given hash.
+func (b *Backend) parityTraceTxInfo(txHash gethcommon.Hash) (rpc.ParityTraceTxInfo, error) {
+	rpcTx, err := b.GetTransactionByHash(txHash)
+	if err != nil {
+		return rpc.ParityTraceTxInfo{}, err
+	}
+	if rpcTx == nil || rpcTx.BlockNumber == nil {
+		return rpc.ParityTraceTxInfo{}, fmt.Errorf("transaction %s not found", txHash.Hex())
+	}
+	blockNumber := rpcTx.BlockNumber.ToInt().Uint64()
+	txInfo := rpc.ParityTraceTxInfo{
+		BlockHash:       rpcTx.BlockHash,
+		BlockNumber:     &blockNumber,
+		TransactionHash: &txHash,
+	}
+	if rpcTx.TransactionIndex != nil {
+		txPosition := uint64(*rpcTx.TransactionIndex)
+		txInfo.TransactionPosition = &txPosition
+	}
+	return txInfo, nil
+}
+
+// decodeCallFrame converts the result of the "callTracer" from the generic
+// JSON value of a [evm.TxTraceResult] into a [rpc.CallFrame].
+func decodeCallFrame(result any) (rpc.CallFrame, error) {
+	var frame rpc.CallFrame
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return frame, fmt.Errorf("failed to encode call trace: %w", err)
+	}
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return frame, fmt.Errorf("failed to decode call trace: %w", err)
+	}
+	return frame, nil
+}
@@ -0,0 +1,134 @@
+package backend_test
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+func (s *BackendSuite) TestTraceBlockFlat() {
+	transfer := s.SuccessfulTxTransfer()
+	traces, err := s.backend.TraceBlockFlat(*transfer.BlockNumberRpc)
+	s.Require().NoError(err)
+
+	var found bool
+	for _, trace := range traces {
+		if *trace.TransactionHash != transfer.Receipt.TxHash {
+			continue
+		}
+		found = true
+		s.Equal("call", trace.Type)
+		s.Equal(s.fundedAccEthAddr, *trace.Action.From)
+		s.Equal(recipient, *trace.Action.To)
+		s.Equal(amountToSend, trace.Action.Value.ToInt())
+		s.Equal(transfer.BlockNumber.Uint64(), *trace.BlockNumber)
+		s.Equal(*transfer.BlockHash, *trace.BlockHash)
+		s.Empty(trace.TraceAddress)
+	}
+	s.Require().True(found, "transfer tx not found in block traces")
+
+	traces, err = s.backend.TraceBlockFlat(1)
+	s.Require().NoError(err)
+	s.Empty(traces)
+}
+
+func (s *BackendSuite) TestTraceTransactionFlat() {
+	deploy := s.SuccessfulTxDeployContract()
+	traces, err := s.backend.TraceTransactionFlat(deploy.Receipt.TxHash)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(traces)
+
+	create := traces[0]
+	s.Equal("create", create.Type)
+	s.Equal(s.fundedAccEthAddr, *create.Action.From)
+	s.Require().NotNil(create.Result)
+	s.Equal(testContractAddress, *create.Result.Address)
+	s.Equal(deploy.BlockNumber.Uint64(), *create.BlockNumber)
+	s.Equal(uint64(deploy.Receipt.TransactionIndex), *create.TransactionPosition)
+
+	_, err = s.backend.TraceTransactionFlat(gethcommon.BytesToHash([]byte("0x0")))
+	s.ErrorContains(err, "not found")
+}
+
+func (s *BackendSuite) TestTraceFilter() {
+	transfer := s.SuccessfulTxTransfer()
+	from := *transfer.BlockNumberRpc
+	to := *s.SuccessfulTxDeployContract().BlockNumberRpc
+
+	s.Run("by recipient", func() {
+		traces, err := s.backend.TraceFilter(rpc.TraceFilterArgs{
+			FromBlock: &from,
+			ToBlock:   &to,
+			ToAddress: []gethcommon.Address{recipient},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(traces, 1)
+		s.Equal(transfer.Receipt.TxHash, *traces[0].TransactionHash)
+	})
+
+	s.Run("created contract is the recipient", func() {
+		traces, err := s.backend.TraceFilter(rpc.TraceFilterArgs{
+			FromBlock: &from,
+			ToBlock:   &to,
+			ToAddress: []gethcommon.Address{testContractAddress},
+		})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(traces)
+		s.Equal("create", traces[0].Type)
+	})
+
+	s.Run("after and count", func() {
+		all, err := s.backend.TraceFilter(rpc.TraceFilterArgs{
+			FromBlock:   &from,
+			ToBlock:     &to,
+			FromAddress: []gethcommon.Address{s.fundedAccEthAddr},
+		})
+		s.Require().NoError(err)
+		s.Require().GreaterOrEqual(len(all), 2)
+
+		after, count := hexutil.Uint64(1), hexutil.Uint64(1)
+		traces, err := s.backend.TraceFilter(rpc.TraceFilterArgs{
+			FromBlock:   &from,
+			ToBlock:     &to,
+			FromAddress: []gethcommon.Address{s.fundedAccEthAddr},
+			After:       &after,
+			Count:       &count,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(traces, 1)
+		s.Equal(all[1].TransactionHash, traces[0].TransactionHash)
+	})
+
+	s.Run("sad: block range exceeds cap", func() {
+		start := rpc.BlockNumber(1)
+		end := rpc.BlockNumber(int64(s.backend.RPCBlockRangeCap()) + 2)
+		_, err := s.backend.TraceFilter(rpc.TraceFilterArgs{
+			FromBlock: &start,
+			ToBlock:   &end,
+		})
+		s.ErrorContains(err, "maximum [from, to] blocks distance")
+	})
+}
+
+func (s *BackendSuite) TestTraceCallFlat() {
+	gas := hexutil.Uint64(params.TxGas)
+	res, err := s.backend.TraceCallFlat(evm.JsonTxArgs{
+		From:  &s.fundedAccEthAddr,
+		To:    &recipient,
+		Gas:   &gas,
+		Value: (*hexutil.Big)(amountToSend),
+	}, rpc.EthLatestBlockNumber)
+	s.Require().NoError(err)
+	s.Require().Len(res.Trace, 1)
+	s.Equal("call", res.Trace[0].Type)
+	s.Nil(res.Trace[0].TransactionHash)
+	s.Nil(res.StateDiff)
+
+	replay, err := s.backend.TraceReplayTransaction(s.SuccessfulTxTransfer().Receipt.TxHash)
+	s.Require().NoError(err)
+	s.Require().Len(replay.Trace, 1)
+	s.Equal(recipient, *replay.Trace[0].Action.To)
+}
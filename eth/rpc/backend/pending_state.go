@@ -0,0 +1,96 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package backend
+
+import (
+	"sort"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// PendingStateTxs returns the eth txs of the mempool that make up the
+// "pending" state: the latest state with these txs applied on top of it, in
+// order. The queries of the "pending" block tag send them to the EVM module,
+// which replays them on a branch of the latest state. See
+// [evm.EthCallRequest].
+//
+// The txs of each sender are sorted by nonce, and the senders are ordered by
+// the position of their first tx in the mempool. Txs that a block could not
+// include, like txs with a nonce gap, are skipped by the replay, so they are
+// not filtered here. The list is cut off at [evm.MaxPendingTxs] txs or
+// [evm.MaxPendingTxsGas] gas, whichever comes first, since the EVM module
+// rejects larger queries.
+func (b *Backend) PendingStateTxs() ([]*evm.MsgEthereumTx, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	senders := []gethcommon.Address{}
+	msgsBySender := make(map[gethcommon.Address][]*evm.MsgEthereumTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to recover sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			if _, found := msgsBySender[sender]; !found {
+				senders = append(senders, sender)
+			}
+			msgsBySender[sender] = append(msgsBySender[sender], ethMsg)
+		}
+	}
+
+	pendingTxs := []*evm.MsgEthereumTx{}
+	var pendingGas uint64
+	for _, sender := range senders {
+		msgs := msgsBySender[sender]
+		sort.SliceStable(msgs, func(i, j int) bool {
+			return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+		})
+		for _, msg := range msgs {
+			gas := msg.GetGas()
+			if len(pendingTxs) == evm.MaxPendingTxs || gas > evm.MaxPendingTxsGas-pendingGas {
+				return pendingTxs, nil
+			}
+			pendingTxs = append(pendingTxs, msg)
+			pendingGas += gas
+		}
+	}
+	return pendingTxs, nil
+}
+
+// PendingAccount returns the nonce and the balance of an account on the
+// "pending" state. See [Backend.PendingStateTxs].
+func (b *Backend) PendingAccount(addr gethcommon.Address) (*evm.QueryPendingAccountResponse, error) {
+	pendingTxs, err := b.PendingStateTxs()
+	if err != nil {
+		return nil, err
+	}
+	return b.queryClient.PendingAccount(b.ctx, &evm.QueryPendingAccountRequest{
+		Address:    addr.Hex(),
+		PendingTxs: pendingTxs,
+	})
+}
+
+// setEthCallPendingTxs runs an eth call or gas estimation on the "pending"
+// state if the block number is the "pending" block tag.
+func (b *Backend) setEthCallPendingTxs(req *evm.EthCallRequest, blockNr rpc.BlockNumber) error {
+	if blockNr != rpc.EthPendingBlockNumber {
+		return nil
+	}
+	pendingTxs, err := b.PendingStateTxs()
+	if err != nil {
+		return err
+	}
+	req.PendingTxs = pendingTxs
+	return nil
+}
@@ -0,0 +1,58 @@
+package backend_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+func (s *BackendSuite) TestPendingState() {
+	testMutex.Lock()
+	defer testMutex.Unlock()
+
+	nonceBefore := s.getCurrentNonce(s.fundedAccEthAddr)
+
+	// Create pending tx: don't wait for next block
+	randomEthAddr := evmtest.NewEthPrivAcc().EthAddr
+	amount := evm.NativeToWei(big.NewInt(2))
+	txHash := s.SendNibiViaEthTransfer(randomEthAddr, amount, false)
+
+	pendingTxs, err := s.backend.PendingStateTxs()
+	s.Require().NoError(err)
+	txFound := false
+	for _, msg := range pendingTxs {
+		if msg.Hash == txHash.Hex() {
+			txFound = true
+		}
+	}
+	s.Require().True(txFound, "pending tx not found in pending state txs")
+
+	s.Run("pending nonce counts the pending tx", func() {
+		s.Equal(nonceBefore+1, s.getCurrentNonce(s.fundedAccEthAddr))
+	})
+
+	s.Run("pending balance includes the pending transfer", func() {
+		pendingBlock := rpc.EthPendingBlockNumber
+		balance, err := s.backend.GetBalance(randomEthAddr, rpc.BlockNumberOrHash{
+			BlockNumber: &pendingBlock,
+		})
+		s.Require().NoError(err)
+		s.Equal(amount, balance.ToInt())
+	})
+
+	s.Run("eth call on the pending state", func() {
+		value := (*hexutil.Big)(evm.NativeToWei(big.NewInt(1)))
+		_, err := s.backend.DoCall(evm.JsonTxArgs{
+			From:  &randomEthAddr,
+			To:    &recipient,
+			Value: value,
+		}, rpc.EthPendingBlockNumber, nil, nil)
+		s.Require().NoError(err)
+	})
+
+	s.NoError(s.network.WaitForNextBlock())
+}
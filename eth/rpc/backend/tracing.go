@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"time"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
@@ -170,6 +173,18 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		}
 	}
 
+	return b.traceBlockMsgs(height, config, block, txsMessages)
+}
+
+// traceBlockMsgs traces the given EVM transactions of a block on top of the
+// state at the beginning of the block. The results are in the order of the
+// transactions.
+func (b *Backend) traceBlockMsgs(
+	height rpc.BlockNumber,
+	config *evm.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	txsMessages []*evm.MsgEthereumTx,
+) ([]*evm.TxTraceResult, error) {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -204,7 +219,7 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		return nil, err
 	}
 
-	decodedResults := make([]*evm.TxTraceResult, txsLength)
+	decodedResults := make([]*evm.TxTraceResult, len(txsMessages))
 	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
 		return nil, err
 	}
@@ -261,3 +276,97 @@ func (b *Backend) TraceCall(
 	}
 	return traceResult, nil
 }
+
+// TraceEthBlock traces the transactions of an Ethereum block that is not
+// necessarily part of the chain, such as an RLP-encoded block passed to
+// "debug_traceBlock". The transactions are executed on top of the state at
+// the end of the parent block (block number minus one), which must exist.
+func (b *Backend) TraceEthBlock(
+	block *gethcore.Block,
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	if block.NumberU64() == 0 {
+		return nil, pkgerrors.New("genesis is not traceable")
+	}
+	txs := block.Transactions()
+	if len(txs) == 0 {
+		return []*evm.TxTraceResult{}, nil
+	}
+
+	parentHeight := block.Number().Int64() - 1
+	parent, err := b.TendermintBlockByNumber(rpc.BlockNumber(max(parentHeight, 1)))
+	if err != nil {
+		return nil, fmt.Errorf("parent block %d not found: %w", parentHeight, err)
+	}
+	if parent == nil || parent.Block == nil {
+		return nil, fmt.Errorf("parent block %d not found", parentHeight)
+	}
+
+	txsMessages := make([]*evm.MsgEthereumTx, len(txs))
+	for i, tx := range txs {
+		msg := new(evm.MsgEthereumTx)
+		if err := msg.FromEthereumTx(tx); err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %w", i, err)
+		}
+		txsMessages[i] = msg
+	}
+
+	nc, ok := b.clientCtx.Client.(cmtrpcclient.NetworkClient)
+	if !ok {
+		return nil, pkgerrors.New("invalid rpc client")
+	}
+	cp, err := nc.ConsensusParams(b.ctx, &parent.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceBlockRequest := &evm.QueryTraceBlockRequest{
+		Txs:             txsMessages,
+		TraceConfig:     config,
+		BlockNumber:     block.Number().Int64(),
+		BlockTime:       time.Unix(int64(block.Time()), 0).UTC(), // #nosec G115
+		BlockHash:       gethcommon.Bytes2Hex(block.Hash().Bytes()),
+		ProposerAddress: b.coinbaseProposerAddress(block.Coinbase(), parent.Block.Height),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	res, err := b.queryClient.TraceBlock(
+		rpc.NewContextWithHeight(parent.Block.Height), traceBlockRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evm.TxTraceResult, len(txs))
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+	return decodedResults, nil
+}
+
+// coinbaseProposerAddress returns the consensus address of the validator whose
+// operator address is the coinbase of a block, since the coinbase of a Nibiru
+// block is the operator address of its proposer. It returns an empty address
+// if no validator matches at the given height.
+func (b *Backend) coinbaseProposerAddress(coinbase gethcommon.Address, height int64) sdk.ConsAddress {
+	valAddr := sdk.ValAddress(coinbase.Bytes())
+	res, err := stakingtypes.NewQueryClient(b.clientCtx).Validator(
+		rpc.NewContextWithHeight(height),
+		&stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()},
+	)
+	if err != nil {
+		b.logger.Debug("no validator for the coinbase", "coinbase", coinbase.Hex(), "error", err.Error())
+		return nil
+	}
+	if err := res.Validator.UnpackInterfaces(b.clientCtx.InterfaceRegistry); err != nil {
+		b.logger.Debug("failed to unpack the validator pubkey", "coinbase", coinbase.Hex(), "error", err.Error())
+		return nil
+	}
+	consAddr, err := res.Validator.GetConsAddr()
+	if err != nil {
+		b.logger.Debug("failed to get the validator cons address", "coinbase", coinbase.Hex(), "error", err.Error())
+		return nil
+	}
+	return consAddr
+}
@@ -181,3 +181,16 @@ func AssertTraceCall(
 	s.Require().Equal(strings.ToLower(recipient.Hex()), trace["to"])
 	s.Require().Equal("0x"+gethcommon.Bytes2Hex(amountToSend.Bytes()), trace["value"])
 }
+
+func (s *BackendSuite) TestTraceEthBlock() {
+	block, err := s.backend.EthBlockByNumber(*s.SuccessfulTxTransfer().BlockNumberRpc)
+	s.Require().NoError(err)
+	s.Require().Len(block.Transactions(), 1)
+
+	res, err := s.backend.TraceEthBlock(block, traceConfigCallTracer())
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	traceResult, err := json.Marshal(res[0].Result)
+	s.Require().NoError(err)
+	AssertTraceCall(s, traceResult)
+}
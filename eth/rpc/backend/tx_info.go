@@ -71,7 +71,7 @@ func (b *Backend) GetTransactionByHash(txHash gethcommon.Hash) (*rpc.EthTxJsonRP
 		return nil, pkgerrors.New("can't find index of ethereum tx")
 	}
 
-	baseFeeWei := evm.BASE_FEE_WEI
+	baseFeeWei := b.blockBaseFeeWei(res.Height)
 	height := uint64(res.Height)    //#nosec G701 -- checked for int overflow already
 	index := uint64(res.EthTxIndex) //#nosec G701 -- checked for int overflow already
 	return rpc.NewRPCTxFromMsgEthTx(
@@ -278,7 +278,7 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	}
 
 	if dynamicTx, ok := txData.(*evm.DynamicFeeTx); ok {
-		baseFeeWei := evm.BASE_FEE_WEI
+		baseFeeWei := b.blockBaseFeeWei(res.Height)
 		receipt.EffectiveGasPrice = (*hexutil.Big)(dynamicTx.EffectiveGasPriceWeiPerGas(baseFeeWei))
 	} else {
 		receipt.EffectiveGasPrice = (*hexutil.Big)(txData.GetGasPrice())
@@ -367,6 +367,101 @@ func (b *Backend) GetTxByTxIndex(height int64, index uint) (*eth.TxResult, error
 	return txResult, nil
 }
 
+// GetLogsFromIndexer returns the logs matching the address and topic criteria
+// from the log index of the EVM tx indexer, for the blocks from "from" up to
+// "indexedTo". The log index covers a contiguous range of blocks, so
+// "indexedTo" is the last block of the range in [from, to] that it covers, or
+// "from - 1" if the indexer is disabled or does not cover block "from". Logs
+// of the blocks after "indexedTo" must be read from the blocks.
+func (b *Backend) GetLogsFromIndexer(
+	from, to int64,
+	addresses []gethcommon.Address,
+	topics [][]gethcommon.Hash,
+	limit int,
+) (logs []*gethcore.Log, indexedTo int64, err error) {
+	indexedTo = from - 1
+	if b.evmTxIndexer == nil {
+		return nil, indexedTo, nil
+	}
+	first, last, err := b.evmTxIndexer.LogIndexRange()
+	if err != nil {
+		return nil, indexedTo, err
+	}
+	if first < 0 || from < first || from > last {
+		return nil, indexedTo, nil
+	}
+	indexedTo = min(to, last)
+	logs, err = b.evmTxIndexer.GetLogs(from, indexedTo, addresses, topics, limit)
+	if err != nil {
+		return nil, from - 1, err
+	}
+	return logs, indexedTo, nil
+}
+
+// Page sizes of "nibiru_getTransactionsByAddress".
+const (
+	DefaultTxsByAddressLimit = 100
+	MaxTxsByAddressLimit     = 1000
+)
+
+// GetTransactionsByAddress returns a page of the EVM txs sent by or to an
+// address in the blocks from "fromBlock" (earliest by default) to "toBlock"
+// (latest by default). Recipients include the contracts created by the txs
+// and the recipients of FunToken precompile transfers. It reads the address
+// indexes of the EVM tx indexer, so it fails if the indexer is disabled.
+func (b *Backend) GetTransactionsByAddress(
+	address gethcommon.Address,
+	fromBlock, toBlock *rpc.BlockNumber,
+	cursor hexutil.Bytes,
+	limit uint64,
+) (*rpc.TxsByAddressResult, error) {
+	if b.evmTxIndexer == nil {
+		return nil, pkgerrors.New("transaction history requires the EVM tx indexer, enable it with json-rpc.enable-indexer")
+	}
+	if limit == 0 {
+		limit = DefaultTxsByAddressLimit
+	}
+	if limit > MaxTxsByAddressLimit {
+		return nil, fmt.Errorf("limit %d exceeds the maximum of %d", limit, MaxTxsByAddressLimit)
+	}
+
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := int64(1), int64(head) // #nosec G115 -- checked by BlockNumber
+	if fromBlock != nil && *fromBlock >= 0 {
+		from = fromBlock.Int64()
+	}
+	if toBlock != nil && *toBlock >= 0 {
+		to = min(toBlock.Int64(), to)
+	}
+
+	txHashes, nextCursor, err := b.evmTxIndexer.GetTxsByAddress(
+		address, from, to, cursor, int(limit), // #nosec G115 -- bounded by MaxTxsByAddressLimit
+	)
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(err, "GetTransactionsByAddress %s", address.Hex())
+	}
+
+	result := &rpc.TxsByAddressResult{Transactions: []*rpc.EthTxJsonRPC{}}
+	for _, txHash := range txHashes {
+		tx, err := b.GetTransactionByHash(txHash)
+		if err != nil {
+			return nil, sdkioerrors.Wrapf(err, "GetTransactionsByAddress: tx %s", txHash.Hex())
+		}
+		if tx == nil {
+			continue
+		}
+		result.Transactions = append(result.Transactions, tx)
+	}
+	if nextCursor != nil {
+		next := hexutil.Bytes(nextCursor)
+		result.NextCursor = &next
+	}
+	return result, nil
+}
+
 // queryTendermintTxIndexer query tx in tendermint tx evmTxIndexer
 func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpc.ParsedTxs) *rpc.ParsedTx) (*eth.TxResult, error) {
 	resTxs, err := b.clientCtx.Client.TxSearch(b.ctx, query, false, nil, nil, "")
@@ -429,7 +524,7 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		msg = ethMsgs[i]
 	}
 
-	baseFeeWei := evm.BASE_FEE_WEI
+	baseFeeWei := b.blockBaseFeeWei(block.Block.Height)
 	height := uint64(block.Block.Height) // #nosec G701 -- checked for int overflow already
 	index := uint64(idx)                 // #nosec G701 -- checked for int overflow already
 	return rpc.NewRPCTxFromMsgEthTx(
@@ -441,3 +536,87 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		b.chainID,
 	)
 }
+
+// GetRawTransaction returns the EIP-2718 binary encoding of the transaction
+// identified by the given Ethereum transaction hash. Both committed and
+// pending (mempool) transactions are considered. If the transaction is not
+// found, this resolves to nil.
+func (b *Backend) GetRawTransaction(txHash gethcommon.Hash) (hexutil.Bytes, error) {
+	msg, err := b.ethMsgByHash(txHash)
+	if err != nil || msg == nil {
+		return nil, err
+	}
+	return msg.AsTransaction().MarshalBinary()
+}
+
+// ethMsgByHash returns the [evm.MsgEthereumTx] identified by the given
+// Ethereum transaction hash from a committed block or, if not found, from the
+// mempool. It returns nil if the transaction is unknown.
+func (b *Backend) ethMsgByHash(txHash gethcommon.Hash) (*evm.MsgEthereumTx, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		txs, err := b.PendingTransactions()
+		if err != nil {
+			b.logger.Debug("tx not found", "hash", txHash.Hex(), "error", err.Error())
+			return nil, nil
+		}
+		for _, tx := range txs {
+			msg, err := evm.UnwrapEthereumMsg(tx, txHash)
+			if err == nil {
+				return msg, nil
+			}
+		}
+		b.logger.Debug("tx not found", "hash", txHash.Hex())
+		return nil, nil
+	}
+
+	block, err := b.TendermintBlockByNumber(rpc.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
+	msg, ok := tx.GetMsgs()[res.MsgIndex].(*evm.MsgEthereumTx)
+	if !ok {
+		return nil, pkgerrors.New("invalid ethereum tx")
+	}
+	return msg, nil
+}
+
+// GetRawReceipts returns the EIP-2718 binary encoding of the receipts of all
+// Ethereum transactions in the given block, in transaction order.
+func (b *Backend) GetRawReceipts(blockNum rpc.BlockNumber) ([]hexutil.Bytes, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", blockNum)
+	}
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	rawReceipts := make([]hexutil.Bytes, 0, len(msgs))
+	for _, msg := range msgs {
+		txHash := gethcommon.HexToHash(msg.Hash)
+		receipt, err := b.GetTransactionReceipt(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt not found for tx %s", txHash.Hex())
+		}
+		bz, err := receipt.Receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		rawReceipts = append(rawReceipts, bz)
+	}
+	return rawReceipts, nil
+}
@@ -217,3 +217,88 @@ func (s *BackendSuite) TestReceiptMarshalJson() {
 	err = json.Unmarshal(jsonBz, receipt)
 	s.Require().NoError(err)
 }
+
+func (s *BackendSuite) TestGetRawTransaction() {
+	s.Run("happy: tx found", func() {
+		txHash := s.SuccessfulTxTransfer().Receipt.TxHash
+		raw, err := s.backend.GetRawTransaction(txHash)
+		s.Require().NoError(err)
+
+		tx := new(gethcore.Transaction)
+		s.Require().NoError(tx.UnmarshalBinary(raw))
+		s.Equal(txHash, tx.Hash())
+	})
+
+	s.Run("sad: tx not found", func() {
+		raw, err := s.backend.GetRawTransaction(gethcommon.BytesToHash([]byte("0x0")))
+		s.Require().NoError(err)
+		s.Nil(raw)
+	})
+}
+
+func (s *BackendSuite) TestGetRawReceipts() {
+	rawReceipts, err := s.backend.GetRawReceipts(*s.SuccessfulTxTransfer().BlockNumberRpc)
+	s.Require().NoError(err)
+	s.Require().Len(rawReceipts, 1)
+
+	receipt := new(gethcore.Receipt)
+	s.Require().NoError(receipt.UnmarshalBinary(rawReceipts[0]))
+	s.Equal(gethcore.ReceiptStatusSuccessful, receipt.Status)
+	s.Greater(receipt.CumulativeGasUsed, uint64(0))
+}
+
+func (s *BackendSuite) TestGetTransactionsByAddress() {
+	transferTx := s.SuccessfulTxTransfer()
+	deployTx := s.SuccessfulTxDeployContract()
+	fromBlock := rpc.NewBlockNumber(transferTx.BlockNumber)
+	toBlock := rpc.NewBlockNumber(deployTx.BlockNumber)
+
+	txHashes := func(res *rpc.TxsByAddressResult) []gethcommon.Hash {
+		hashes := []gethcommon.Hash{}
+		for _, tx := range res.Transactions {
+			hashes = append(hashes, tx.Hash)
+		}
+		return hashes
+	}
+
+	s.Run("sender", func() {
+		res, err := s.backend.GetTransactionsByAddress(s.fundedAccEthAddr, &fromBlock, &toBlock, nil, 0)
+		s.Require().NoError(err)
+		s.Equal(
+			[]gethcommon.Hash{transferTx.Receipt.TxHash, deployTx.Receipt.TxHash},
+			txHashes(res),
+		)
+		s.Nil(res.NextCursor)
+	})
+
+	s.Run("recipient", func() {
+		res, err := s.backend.GetTransactionsByAddress(recipient, &fromBlock, &fromBlock, nil, 0)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{transferTx.Receipt.TxHash}, txHashes(res))
+	})
+
+	s.Run("created contract", func() {
+		res, err := s.backend.GetTransactionsByAddress(testContractAddress, nil, &toBlock, nil, 0)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{deployTx.Receipt.TxHash}, txHashes(res))
+	})
+
+	s.Run("pages", func() {
+		res, err := s.backend.GetTransactionsByAddress(s.fundedAccEthAddr, &fromBlock, &toBlock, nil, 1)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{transferTx.Receipt.TxHash}, txHashes(res))
+		s.Require().NotNil(res.NextCursor)
+
+		res, err = s.backend.GetTransactionsByAddress(s.fundedAccEthAddr, &fromBlock, &toBlock, *res.NextCursor, 1)
+		s.Require().NoError(err)
+		s.Equal([]gethcommon.Hash{deployTx.Receipt.TxHash}, txHashes(res))
+		s.Nil(res.NextCursor)
+	})
+
+	s.Run("sad: limit above the maximum", func() {
+		_, err := s.backend.GetTransactionsByAddress(
+			s.fundedAccEthAddr, nil, nil, nil, backend.MaxTxsByAddressLimit+1,
+		)
+		s.Require().ErrorContains(err, "exceeds the maximum")
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package backend
+
+import (
+	"fmt"
+	"sort"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// TxPoolContent is the mempool view served by the "txpool" JSON-RPC namespace.
+// Transactions are grouped by sender and then by nonce.
+//
+//   - Pending: Transactions that are executable against the current state,
+//     meaning their nonces form a contiguous sequence starting at the account
+//     nonce stored in the EVM state.
+//   - Queued: Transactions with a future nonce that leaves a gap after the
+//     account nonce. These cannot be executed until the gap is filled.
+//
+// Transactions with a nonce below the account nonce are stale and are
+// omitted, since they will be rejected once they reach the front of the pool.
+type TxPoolContent struct {
+	Pending map[gethcommon.Address]map[uint64]*evm.MsgEthereumTx
+	Queued  map[gethcommon.Address]map[uint64]*evm.MsgEthereumTx
+}
+
+// PendingEthMsgs returns the [evm.MsgEthereumTx] messages contained in the
+// CometBFT mempool, keyed by sender address. Non-Ethereum txs and messages
+// whose sender cannot be recovered are skipped.
+func (b *Backend) PendingEthMsgs() (map[gethcommon.Address][]*evm.MsgEthereumTx, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	msgsBySender := make(map[gethcommon.Address][]*evm.MsgEthereumTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to recover sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			msgsBySender[sender] = append(msgsBySender[sender], ethMsg)
+		}
+	}
+	return msgsBySender, nil
+}
+
+// TxPoolContent returns the content of the mempool split into executable
+// ("pending") and future ("queued") transactions. See [TxPoolContent].
+func (b *Backend) TxPoolContent() (*TxPoolContent, error) {
+	msgsBySender, err := b.PendingEthMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	content := &TxPoolContent{
+		Pending: make(map[gethcommon.Address]map[uint64]*evm.MsgEthereumTx),
+		Queued:  make(map[gethcommon.Address]map[uint64]*evm.MsgEthereumTx),
+	}
+	for sender, msgs := range msgsBySender {
+		pending, queued, err := b.splitPendingAndQueued(sender, msgs)
+		if err != nil {
+			return nil, err
+		}
+		if len(pending) > 0 {
+			content.Pending[sender] = pending
+		}
+		if len(queued) > 0 {
+			content.Queued[sender] = queued
+		}
+	}
+	return content, nil
+}
+
+// TxPoolContentFrom returns the pending and queued transactions of a single
+// sender. See [TxPoolContent].
+func (b *Backend) TxPoolContentFrom(
+	sender gethcommon.Address,
+) (pending, queued map[uint64]*evm.MsgEthereumTx, err error) {
+	msgsBySender, err := b.PendingEthMsgs()
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.splitPendingAndQueued(sender, msgsBySender[sender])
+}
+
+// splitPendingAndQueued sorts the mempool txs of a sender by nonce and
+// partitions them relative to the account nonce from the EVM state.
+func (b *Backend) splitPendingAndQueued(
+	sender gethcommon.Address, msgs []*evm.MsgEthereumTx,
+) (pending, queued map[uint64]*evm.MsgEthereumTx, err error) {
+	pending = make(map[uint64]*evm.MsgEthereumTx)
+	queued = make(map[uint64]*evm.MsgEthereumTx)
+	if len(msgs) == 0 {
+		return pending, queued, nil
+	}
+
+	nextNonce, err := b.evmAccountNonce(sender)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+	})
+	for _, msg := range msgs {
+		nonce := msg.AsTransaction().Nonce()
+		switch {
+		case nonce < nextNonce:
+			// stale: already consumed in state, or a duplicate nonce
+			continue
+		case nonce == nextNonce:
+			pending[nonce] = msg
+			nextNonce++
+		default:
+			queued[nonce] = msg
+		}
+	}
+	return pending, queued, nil
+}
+
+// evmAccountNonce returns the nonce of the account in the latest committed EVM
+// state. Accounts that do not exist yet have a nonce of 0.
+func (b *Backend) evmAccountNonce(addr gethcommon.Address) (uint64, error) {
+	res, err := b.queryClient.EthAccount(b.ctx, &evm.QueryEthAccountRequest{
+		Address: addr.Hex(),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		// treat as account doesn't exist yet
+		if ok && st.Code() == codes.NotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return res.Nonce, nil
+}
+
+// TxPoolRPCTx converts a mempool tx into its JSON-RPC representation. Block
+// fields are left empty because the tx is not included in a block yet.
+func (b *Backend) TxPoolRPCTx(msg *evm.MsgEthereumTx) (*rpc.EthTxJsonRPC, error) {
+	return rpc.NewRPCTxFromMsgEthTx(
+		msg,
+		gethcommon.Hash{},
+		uint64(0),
+		uint64(0),
+		nil,
+		b.chainID,
+	)
+}
+
+// TxPoolInspectSummary returns the human-readable summary of a mempool tx
+// used by "txpool_inspect", matching the format of go-ethereum:
+// "<to>: <value> wei + <gas> gas × <gasPrice> wei".
+func TxPoolInspectSummary(msg *evm.MsgEthereumTx) string {
+	tx := msg.AsTransaction()
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+}
@@ -0,0 +1,63 @@
+package backend_test
+
+import (
+	"math/big"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+func (s *BackendSuite) TestTxPoolContent() {
+	testMutex.Lock()
+	defer testMutex.Unlock()
+
+	// Create pending tx: don't wait for next block
+	randomEthAddr := evmtest.NewEthPrivAcc().EthAddr
+	txHash := s.SendNibiViaEthTransfer(randomEthAddr, big.NewInt(123), false)
+
+	pending, queued, err := s.backend.TxPoolContentFrom(s.fundedAccEthAddr)
+	s.Require().NoError(err)
+	s.Require().Empty(queued)
+
+	txFound := false
+	for nonce, msg := range pending {
+		s.Equal(nonce, msg.AsTransaction().Nonce())
+		if msg.Hash == txHash.Hex() {
+			txFound = true
+		}
+	}
+	s.Require().True(txFound, "pending tx not found in txpool content")
+
+	content, err := s.backend.TxPoolContent()
+	s.Require().NoError(err)
+	s.Require().Contains(content.Pending, s.fundedAccEthAddr)
+
+	s.NoError(s.network.WaitForNextBlock())
+}
+
+func (s *BackendSuite) TestTxPoolInspectSummary() {
+	to := evmtest.NewEthPrivAcc().EthAddr
+	transfer := evm.NewTx(&evm.EvmTxArgs{
+		Nonce:    1,
+		To:       &to,
+		Amount:   big.NewInt(10),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(2),
+	})
+	s.Equal(
+		to.Hex()+": 10 wei + 21000 gas × 2 wei",
+		backend.TxPoolInspectSummary(transfer),
+	)
+
+	create := evm.NewTx(&evm.EvmTxArgs{
+		Nonce:    1,
+		Amount:   big.NewInt(0),
+		GasLimit: 50000,
+		GasPrice: big.NewInt(3),
+	})
+	s.Equal(
+		"contract creation: 0 wei + 50000 gas × 3 wei",
+		backend.TxPoolInspectSummary(create),
+	)
+}
@@ -15,7 +15,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -45,8 +44,9 @@ func (s sortGasAndReward) Less(i, j int) bool {
 }
 
 // getAccountNonce returns the account nonce for the given account address.
-// If the pending value is true, it will iterate over the mempool (pending)
-// txs in order to compute and return the pending tx sequence.
+// If the pending value is true, it returns the nonce of the account on the
+// "pending" state, which accounts for the executable mempool txs of the
+// account. See [Backend.PendingStateTxs].
 func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height int64, logger log.Logger) (uint64, error) {
 	queryClient := authtypes.NewQueryClient(b.clientCtx)
 	adr := sdk.AccAddress(accAddr.Bytes()).String()
@@ -71,35 +71,14 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 		return nonce, nil
 	}
 
-	// the account retriever doesn't include the uncommitted transactions on the nonce,
-	// so we need to manually add them.
-	pendingTxs, err := b.PendingTransactions()
+	// the account retriever doesn't include the uncommitted transactions on the
+	// nonce, so the nonce is read from the "pending" state instead.
+	pendingAcc, err := b.PendingAccount(accAddr)
 	if err != nil {
-		logger.Error("failed to fetch pending transactions", "error", err.Error())
+		logger.Error("failed to query pending account", "error", err.Error())
 		return nonce, nil
 	}
-
-	// add the uncommitted txs to the nonce counter
-	// only supports `MsgEthereumTx` style tx
-	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
-			ethMsg, ok := msg.(*evm.MsgEthereumTx)
-			if !ok {
-				// not ethereum tx
-				break
-			}
-
-			sender, err := ethMsg.GetSender(b.chainID)
-			if err != nil {
-				continue
-			}
-			if sender == accAddr {
-				nonce++
-			}
-		}
-	}
-
-	return nonce, nil
+	return pendingAcc.Nonce, nil
 }
 
 // retrieveEVMTxFeesFromBlock goes through evm txs of the block,
@@ -113,16 +92,16 @@ func (b *Backend) retrieveEVMTxFeesFromBlock(
 	targetOneFeeHistory *rpc.OneFeeHistory,
 ) error {
 	blockHeight := tendermintBlock.Block.Height
-	blockBaseFee := evm.BASE_FEE_WEI
+	blockBaseFee := b.blockBaseFeeWei(blockHeight)
 
 	// set basefee
 	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	header, err := b.CurrentHeader()
+	nextBaseFee, err := b.NextBaseFeeWei(blockHeight)
 	if err != nil {
-		return err
+		b.logger.Debug("failed to query next base fee", "height", blockHeight, "error", err.Error())
+		nextBaseFee = blockBaseFee
 	}
-	targetOneFeeHistory.NextBaseFee = eip1559.CalcBaseFee(cfg, header)
+	targetOneFeeHistory.NextBaseFee = nextBaseFee
 
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
@@ -0,0 +1,246 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpc
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// CallFrame is the nested call frame returned by geth's native "callTracer".
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.14.13/eth/tracers/native/call.go
+type CallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	To           *common.Address `json:"to,omitempty"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []CallFrame     `json:"calls,omitempty"`
+	Value        *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ParityTrace is a single flat trace in the format of the Parity/OpenEthereum
+// "trace" JSON-RPC namespace. A transaction produces one ParityTrace per call
+// frame, ordered depth-first, with "traceAddress" locating the frame in the
+// call tree.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           *common.Hash       `json:"blockHash,omitempty"`
+	BlockNumber         *uint64            `json:"blockNumber,omitempty"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *common.Hash       `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64            `json:"transactionPosition,omitempty"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceAction is the "action" of a [ParityTrace]. The fields that are set
+// depend on the trace type:
+//   - "call": callType, from, to, gas, input, value
+//   - "create": creationMethod, from, gas, init, value
+//   - "suicide": address, refundAddress, balance
+type ParityTraceAction struct {
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// ParityTraceResult is the "result" of a [ParityTrace]. Calls set "gasUsed"
+// and "output", while contract creations set "gasUsed", "address" and "code".
+type ParityTraceResult struct {
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// ParityTraceTxInfo locates the transaction of a flat trace in the chain. The
+// fields are left out of the traces if nil, as in "trace_call".
+type ParityTraceTxInfo struct {
+	BlockHash           *common.Hash
+	BlockNumber         *uint64
+	TransactionHash     *common.Hash
+	TransactionPosition *uint64
+}
+
+// FlattenCallFrame converts the nested call frame of a transaction from geth's
+// "callTracer" into Parity flat traces, ordered depth-first.
+func FlattenCallFrame(frame CallFrame, txInfo ParityTraceTxInfo) []ParityTrace {
+	var traces []ParityTrace
+	flattenCallFrame(frame, []int{}, txInfo, &traces)
+	return traces
+}
+
+func flattenCallFrame(
+	frame CallFrame, traceAddress []int, txInfo ParityTraceTxInfo, traces *[]ParityTrace,
+) {
+	trace := ParityTrace{
+		BlockHash:           txInfo.BlockHash,
+		BlockNumber:         txInfo.BlockNumber,
+		Subtraces:           len(frame.Calls),
+		TraceAddress:        traceAddress,
+		TransactionHash:     txInfo.TransactionHash,
+		TransactionPosition: txInfo.TransactionPosition,
+	}
+
+	from := frame.From
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	switch frameType := strings.ToUpper(frame.Type); frameType {
+	case vm.CREATE.String(), vm.CREATE2.String():
+		trace.Type = "create"
+		gas, init := frame.Gas, frame.Input
+		trace.Action = ParityTraceAction{
+			CreationMethod: strings.ToLower(frameType),
+			From:           &from,
+			Gas:            &gas,
+			Init:           &init,
+			Value:          value,
+		}
+		if frame.Error == "" {
+			gasUsed, code := frame.GasUsed, frame.Output
+			trace.Result = &ParityTraceResult{
+				GasUsed: &gasUsed,
+				Address: frame.To,
+				Code:    &code,
+			}
+		}
+	case vm.SELFDESTRUCT.String():
+		trace.Type = "suicide"
+		trace.Action = ParityTraceAction{
+			Address:       &from,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+	default:
+		trace.Type = "call"
+		gas, input := frame.Gas, frame.Input
+		trace.Action = ParityTraceAction{
+			CallType: strings.ToLower(frameType),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		if frameType == vm.DELEGATECALL.String() || frameType == vm.STATICCALL.String() {
+			// Parity omits the value of calls that cannot transfer funds.
+			trace.Action.Value = nil
+		}
+		if frame.Error == "" {
+			gasUsed, output := frame.GasUsed, frame.Output
+			trace.Result = &ParityTraceResult{
+				GasUsed: &gasUsed,
+				Output:  &output,
+			}
+		}
+	}
+	trace.Error = parityTraceError(frame.Error)
+	*traces = append(*traces, trace)
+
+	for i, child := range frame.Calls {
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = i
+		flattenCallFrame(child, childAddress, txInfo, traces)
+	}
+}
+
+// parityTraceError maps the EVM error messages of geth to the error messages
+// used by Parity traces.
+func parityTraceError(err string) string {
+	switch err {
+	case "":
+		return ""
+	case vm.ErrExecutionReverted.Error():
+		return "Reverted"
+	case vm.ErrOutOfGas.Error(), vm.ErrCodeStoreOutOfGas.Error():
+		return "Out of gas"
+	case vm.ErrInvalidJump.Error():
+		return "Bad jump destination"
+	case vm.ErrWriteProtection.Error():
+		return "Mutable Call In Static Context"
+	case vm.ErrDepth.Error():
+		return "Out of stack"
+	default:
+		return err
+	}
+}
+
+// Matches reports whether the trace matches the address filters of
+// "trace_filter". A trace matches if its sender is in "fromAddresses" and its
+// recipient is in "toAddresses", where an empty list matches any address. The
+// recipient of a contract creation is the created contract.
+func (trace ParityTrace) Matches(fromAddresses, toAddresses []common.Address) bool {
+	var from, to *common.Address
+	switch trace.Type {
+	case "create":
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	case "suicide":
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+	return addrIn(from, fromAddresses) && addrIn(to, toAddresses)
+}
+
+func addrIn(addr *common.Address, addrs []common.Address) bool {
+	if len(addrs) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addrs {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ParityTraceResults is the result of "trace_call" and
+// "trace_replayTransaction". Only the "trace" trace type is supported, so
+// "stateDiff" and "vmTrace" are always null.
+type ParityTraceResults struct {
+	Output    hexutil.Bytes `json:"output"`
+	StateDiff any           `json:"stateDiff"`
+	Trace     []ParityTrace `json:"trace"`
+	VmTrace   any           `json:"vmTrace"`
+}
+
+// TraceFilterArgs are the arguments of "trace_filter".
+//   - FromBlock, ToBlock: The block range to search. Both default to latest.
+//   - FromAddress, ToAddress: Address filters. See [ParityTrace.Matches].
+//   - After: Number of matching traces to skip.
+//   - Count: Maximum number of traces to return.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *hexutil.Uint64  `json:"after"`
+	Count       *hexutil.Uint64  `json:"count"`
+}
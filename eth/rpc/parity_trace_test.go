@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		callee   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		created  = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	)
+	frame := CallFrame{
+		Type:    "CALL",
+		From:    sender,
+		To:      &contract,
+		Gas:     100_000,
+		GasUsed: 60_000,
+		Input:   hexutil.Bytes{0xaa},
+		Output:  hexutil.Bytes{0xbb},
+		Value:   (*hexutil.Big)(big.NewInt(5)),
+		Calls: []CallFrame{
+			{
+				Type:  "STATICCALL",
+				From:  contract,
+				To:    &callee,
+				Gas:   30_000,
+				Input: hexutil.Bytes{0xcc},
+				Error: "execution reverted",
+			},
+			{
+				Type:    "CREATE2",
+				From:    contract,
+				To:      &created,
+				Gas:     40_000,
+				GasUsed: 20_000,
+				Input:   hexutil.Bytes{0x60},
+				Output:  hexutil.Bytes{0x00},
+				Calls: []CallFrame{
+					{Type: "SELFDESTRUCT", From: created, To: &sender},
+				},
+			},
+		},
+	}
+
+	blockNumber, txPosition := uint64(7), uint64(1)
+	blockHash, txHash := common.HexToHash("0xb1"), common.HexToHash("0xa1")
+	traces := FlattenCallFrame(frame, ParityTraceTxInfo{
+		BlockHash:           &blockHash,
+		BlockNumber:         &blockNumber,
+		TransactionHash:     &txHash,
+		TransactionPosition: &txPosition,
+	})
+	require.Len(t, traces, 4)
+
+	wantTypes := []string{"call", "call", "create", "suicide"}
+	wantAddrs := [][]int{{}, {0}, {1}, {1, 0}}
+	wantSubtraces := []int{2, 0, 1, 0}
+	for i, trace := range traces {
+		require.Equal(t, wantTypes[i], trace.Type, "trace %d", i)
+		require.Equal(t, wantAddrs[i], trace.TraceAddress, "trace %d", i)
+		require.Equal(t, wantSubtraces[i], trace.Subtraces, "trace %d", i)
+		require.Equal(t, txHash, *trace.TransactionHash)
+		require.Equal(t, txPosition, *trace.TransactionPosition)
+	}
+
+	root := traces[0]
+	require.Equal(t, "call", root.Action.CallType)
+	require.Equal(t, contract, *root.Action.To)
+	require.Equal(t, big.NewInt(5), root.Action.Value.ToInt())
+	require.Equal(t, uint64(60_000), uint64(*root.Result.GasUsed))
+	require.Equal(t, hexutil.Bytes{0xbb}, *root.Result.Output)
+
+	reverted := traces[1]
+	require.Equal(t, "staticcall", reverted.Action.CallType)
+	require.Nil(t, reverted.Action.Value)
+	require.Equal(t, "Reverted", reverted.Error)
+	require.Nil(t, reverted.Result)
+
+	create := traces[2]
+	require.Equal(t, "create2", create.Action.CreationMethod)
+	require.Equal(t, hexutil.Bytes{0x60}, *create.Action.Init)
+	require.Equal(t, created, *create.Result.Address)
+
+	suicide := traces[3]
+	require.Equal(t, created, *suicide.Action.Address)
+	require.Equal(t, sender, *suicide.Action.RefundAddress)
+
+	// A reverted trace is serialized with a null result
+	bz, err := json.Marshal(reverted)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"result":null`)
+
+	// Matches: created contracts are the recipient of create traces
+	require.True(t, create.Matches(nil, []common.Address{created}))
+	require.True(t, root.Matches([]common.Address{sender}, []common.Address{contract}))
+	require.False(t, root.Matches([]common.Address{contract}, nil))
+	require.False(t, reverted.Matches(nil, []common.Address{contract}))
+}
@@ -30,6 +30,10 @@ const (
 	NamespaceNet    = "net"
 	NamespaceTxPool = "txpool"
 	NamespaceDebug  = "debug"
+	NamespaceTrace  = "trace"
+
+	// Nibiru namespaces
+	NamespaceNibiru = "nibiru"
 
 	apiVersion = "1.0"
 )
@@ -90,12 +94,18 @@ func init() {
 				},
 			}
 		},
-		NamespaceTxPool: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ eth.EVMTxIndexer) []rpc.API {
+		NamespaceTxPool: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: NamespaceTxPool,
 					Version:   apiVersion,
-					Service:   NewImplTxPoolAPI(ctx.Logger),
+					Service:   NewImplTxPoolAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -116,6 +126,38 @@ func init() {
 				},
 			}
 		},
+		NamespaceTrace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceTrace,
+					Version:   apiVersion,
+					Service:   NewImplTraceAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		NamespaceNibiru: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceNibiru,
+					Version:   apiVersion,
+					Service:   NewImplNibiruAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
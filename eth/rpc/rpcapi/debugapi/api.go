@@ -4,7 +4,6 @@ package debugapi
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -24,6 +23,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	getheth "github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -344,9 +344,25 @@ func (a *DebugAPI) PrintBlock(number uint64) (string, error) {
 
 // IntermediateRoots executes a block, and returns a list
 // of intermediate roots: the stateroot after each transaction.
+//
+// Nibiru does not have per-transaction state roots. The state root (app hash)
+// of a CometBFT block is only computed once at the end of the block, so this
+// method returns an error for blocks that contain transactions.
 func (a *DebugAPI) IntermediateRoots(hash common.Hash, _ *evm.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %#x not found", hash)
+	}
+	if len(resBlock.Block.Txs) == 0 {
+		return []common.Hash{}, nil
+	}
+	return nil, errors.New(
+		"intermediate state roots are not available: the state root is only computed at the end of each block",
+	)
 }
 
 // GetBadBlocks returns a list of the last 'bad blocks' that the client has seen
@@ -360,11 +376,25 @@ func ErrNotImplemented(method string) error {
 	return fmt.Errorf("method is not implemented: %v", method)
 }
 
+// errBadBlockNotFound is returned by the bad block methods. Blocks are final
+// once committed by CometBFT consensus, so invalid blocks are never imported
+// and there are no bad blocks to return.
+func errBadBlockNotFound(hash common.Hash) error {
+	return fmt.Errorf("bad block %#x not found", hash)
+}
+
 // GetRawBlock returns an RLP-encoded block
 func (a *DebugAPI) GetRawBlock(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
-	fnName := "debug_getRawBlock"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := a.backend.EthBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block)
 }
 
 // GetRawReceipts returns an array of EIP-2718 binary-encoded receipts
@@ -372,9 +402,12 @@ func (a *DebugAPI) GetRawReceipts(
 	ctx context.Context,
 	blockNrOrHash rpc.BlockNumberOrHash,
 ) ([]hexutil.Bytes, error) {
-	fnName := "debug_getRawReceipts"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.GetRawReceipts(blockNum)
 }
 
 // GetRawHeader returns an RLP-encoded block header
@@ -382,9 +415,16 @@ func (a *DebugAPI) GetRawHeader(
 	ctx context.Context,
 	blockNrOrHash rpc.BlockNumberOrHash,
 ) (hexutil.Bytes, error) {
-	fnName := "debug_getRawHeader"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_getRawHeader", "block number or hash", blockNrOrHash)
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	header, err := a.backend.HeaderByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(header)
 }
 
 // GetRawTransaction returns the bytes of the transaction for the given hash.
@@ -392,82 +432,133 @@ func (a *DebugAPI) GetRawTransaction(
 	ctx context.Context,
 	hash common.Hash,
 ) (hexutil.Bytes, error) {
-	fnName := "debug_getRawTransaction"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_getRawTransaction", "hash", hash)
+	return a.backend.GetRawTransaction(hash)
 }
 
 // StandardTraceBadBlockToFile dumps the structured logs created during the
 // execution of EVM against a block pulled from the pool of bad ones to the
 // local file system and returns a list of files to the caller.
+//
+// There are no bad blocks on Nibiru (see [DebugAPI.GetBadBlocks]), so this
+// always returns a "not found" error.
 func (a *DebugAPI) StandardTraceBadBlockToFile(
 	ctx context.Context,
 	hash common.Hash,
 	config *tracers.StdTraceConfig,
 ) ([]string, error) {
-	fnName := "debug_standardTraceBadBlockToFile"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_standardTraceBadBlockToFile", "hash", hash)
+	return nil, errBadBlockNotFound(hash)
 }
 
 // StandardTraceBlockToFile dumps the structured logs created during the
 // execution of EVM to the local file system and returns a list of files
-// to the caller.
+// to the caller. Each Ethereum tx of the block is written to its own
+// temporary file, unless "txHash" is set in the config to select one tx.
 func (a *DebugAPI) StandardTraceBlockToFile(
 	ctx context.Context,
 	hash common.Hash,
 	config *tracers.StdTraceConfig,
 ) ([]string, error) {
-	fnName := "debug_standardTraceBlockToFile"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_standardTraceBlockToFile", "hash", hash)
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %#x not found", hash)
+	}
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	traceConfig := &evm.TraceConfig{}
+	var txHash common.Hash
+	if config != nil {
+		traceConfig.EnableMemory = config.EnableMemory
+		traceConfig.DisableStack = config.DisableStack
+		traceConfig.DisableStorage = config.DisableStorage
+		traceConfig.EnableReturnData = config.EnableReturnData
+		traceConfig.Debug = config.Debug
+		traceConfig.Limit = int32(config.Limit) // #nosec G115
+		txHash = config.TxHash
+	}
+
+	var files []string
+	for i, msg := range a.backend.EthMsgsFromTendermintBlock(resBlock, blockRes) {
+		msgHash := common.HexToHash(msg.Hash)
+		if txHash != (common.Hash{}) && txHash != msgHash {
+			continue
+		}
+		res, err := a.backend.TraceTransaction(msgHash, traceConfig)
+		if err != nil {
+			return files, err
+		}
+		file, err := writeTraceToTempFile(
+			fmt.Sprintf("block_%#x-%d-%#x-", hash.Bytes()[:4], i, msgHash.Bytes()[:4]),
+			res,
+		)
+		if err != nil {
+			return files, err
+		}
+		a.logger.Info("Wrote standard trace", "file", file)
+		files = append(files, file)
+	}
+	if txHash != (common.Hash{}) && len(files) == 0 {
+		return nil, fmt.Errorf("transaction %#x not found in block", txHash)
+	}
+	return files, nil
 }
 
 // TraceBadBlock returns the structured logs created during the execution of
 // EVM against a block pulled from the pool of bad ones and returns them as a JSON
 // object.
+//
+// There are no bad blocks on Nibiru (see [DebugAPI.GetBadBlocks]), so this
+// always returns a "not found" error.
 func (a *DebugAPI) TraceBadBlock(
 	ctx context.Context,
 	hash common.Hash,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBadBlock"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBadBlock", "hash", hash)
+	return nil, errBadBlockNotFound(hash)
 }
 
 // TraceBlock returns the structured logs created during the execution of EVM
-// and returns them as a JSON object.
+// and returns them as a JSON object. The block is given as an RLP-encoded
+// Ethereum block and its transactions are executed on top of the state of its
+// parent block.
 func (a *DebugAPI) TraceBlock(
 	ctx context.Context,
 	blob hexutil.Bytes,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBlock"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlock", "size", len(blob))
+	block := new(gethcore.Block)
+	if err := rlp.DecodeBytes(blob, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %w", err)
+	}
+	return a.backend.TraceEthBlock(block, config)
 }
 
 // TraceBlockFromFile returns the structured logs created during the execution of
-// EVM and returns them as a JSON object.
+// EVM and returns them as a JSON object. The file holds an RLP-encoded
+// Ethereum block, see [DebugAPI.TraceBlock].
 func (a *DebugAPI) TraceBlockFromFile(
 	ctx context.Context,
 	file string,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBlockFromFile"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
-}
-
-// TraceChain returns the structured logs created during the execution of EVM
-// between two blocks (excluding start) and returns them as a JSON object.
-func (a *DebugAPI) TraceChain(
-	ctx context.Context,
-	start, end rpc.BlockNumber,
-	config *tracers.TraceConfig,
-) (subscription any, err error) { // Fetch the block interval that we want to trace
-	fnName := "debug_traceChain"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlockFromFile", "file", file)
+	fp, err := ExpandHome(file)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := os.ReadFile(fp) // #nosec G304
+	if err != nil {
+		return nil, fmt.Errorf("could not read file: %w", err)
+	}
+	return a.TraceBlock(ctx, blob, config)
 }
@@ -0,0 +1,123 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package debugapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// BlockTraceResult is the result of tracing a single block in
+// "debug_traceChain".
+type BlockTraceResult struct {
+	Block  hexutil.Uint64       `json:"block"`
+	Hash   common.Hash          `json:"hash"`
+	Traces []*evm.TxTraceResult `json:"traces"`
+}
+
+// TraceChain returns the structured logs created during the execution of EVM
+// between two blocks (excluding start) and returns them as a JSON object.
+//
+// The results are streamed block by block as notifications of a subscription,
+// so this method is only available over websockets, where it is called with
+// "debug_subscribe" and the "traceChain" subscription name.
+func (a *DebugAPI) TraceChain(
+	ctx context.Context,
+	start, end rpc.BlockNumber,
+	config *evm.TraceConfig,
+) (*gethrpc.Subscription, error) {
+	a.logger.Debug("debug_traceChain", "start", start, "end", end)
+	notifier, supported := gethrpc.NotifierFromContext(ctx)
+	if !supported {
+		return &gethrpc.Subscription{}, gethrpc.ErrNotificationsUnsupported
+	}
+	from, to, err := a.TraceChainRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	go func() {
+		err := a.StreamTraceChain(from, to, config, rpcSub.Err(), func(res *BlockTraceResult) error {
+			return notifier.Notify(rpcSub.ID, res)
+		})
+		if err != nil {
+			a.logger.Debug("debug_traceChain stopped", "error", err.Error())
+		}
+	}()
+	return rpcSub, nil
+}
+
+// TraceChainRange resolves the block range of "debug_traceChain" and returns
+// the first and last block to trace. The start block itself is excluded. As
+// for "trace_filter", the number of blocks to trace may not exceed the
+// "BlockRangeCap" of the JSON-RPC config.
+func (a *DebugAPI) TraceChainRange(start, end rpc.BlockNumber) (from, to int64, err error) {
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return 0, 0, err
+	}
+	resolve := func(n rpc.BlockNumber) int64 {
+		if n < 0 { // "latest", "pending", "safe" or "finalized"
+			return int64(latest) // #nosec G115
+		}
+		return n.Int64()
+	}
+	from, to = resolve(start)+1, resolve(end)
+	switch {
+	case to < from:
+		return 0, 0, fmt.Errorf("end block (#%d) needs to come after start block (#%d)", to, from-1)
+	case to > int64(latest): // #nosec G115
+		return 0, 0, fmt.Errorf("end block #%d is beyond the latest block #%d", to, latest)
+	}
+	if blockLimit := int64(a.backend.RPCBlockRangeCap()); to-from+1 > blockLimit {
+		return 0, 0, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+	return from, to, nil
+}
+
+// StreamTraceChain traces the blocks from "from" to "to" (inclusive) in order
+// and passes the result of each block to "notify". It returns early when
+// "stop" receives a value or is closed, or when tracing or "notify" fails.
+// The range is expected to come from [DebugAPI.TraceChainRange], which
+// enforces the block range cap.
+func (a *DebugAPI) StreamTraceChain(
+	from, to int64,
+	config *evm.TraceConfig,
+	stop <-chan error,
+	notify func(*BlockTraceResult) error,
+) error {
+	for height := from; height <= to; height++ {
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+
+		resBlock, err := a.backend.TendermintBlockByNumber(rpc.BlockNumber(height))
+		if err != nil {
+			return err
+		}
+		if resBlock == nil || resBlock.Block == nil {
+			return fmt.Errorf("block #%d not found", height)
+		}
+		traces, err := a.backend.TraceBlock(rpc.BlockNumber(height), config, resBlock)
+		if err != nil {
+			return fmt.Errorf("failed to trace block #%d: %w", height, err)
+		}
+		if err := notify(&BlockTraceResult{
+			Block:  hexutil.Uint64(height), // #nosec G115
+			Hash:   common.BytesToHash(resBlock.BlockID.Hash),
+			Traces: traces,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
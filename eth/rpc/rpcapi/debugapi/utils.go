@@ -2,6 +2,7 @@
 package debugapi
 
 import (
+	"encoding/json"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -59,3 +60,17 @@ func writeProfile(name, file string, log log.Logger) error {
 
 	return f.Close()
 }
+
+// writeTraceToTempFile writes a JSON trace to a new temporary file whose name
+// starts with the given prefix and returns the file name.
+func writeTraceToTempFile(prefix string, trace json.RawMessage) (string, error) {
+	f, err := os.CreateTemp("", prefix)
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(trace); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
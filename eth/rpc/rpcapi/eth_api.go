@@ -64,8 +64,14 @@ type IEthAPI interface {
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
 	EstimateGas(
-		args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+		args evm.JsonTxArgs,
+		blockNrOptional *rpc.BlockNumber,
+		stateOverrides *rpc.StateOverride,
+		blockOverrides *evm.BlockOverrides,
 	) (hexutil.Uint64, error)
+	SimulateV1(
+		opts evm.SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+	) ([]rpc.SimBlockResult, error)
 	FeeHistory(
 		blockCount gethmath.HexOrDecimal64,
 		lastBlock gethrpc.BlockNumber,
@@ -290,9 +296,14 @@ func (e *EthAPI) GetProof(address common.Address,
 //
 // Allows developers to read data from the blockchain which includes executing
 // smart contracts. However, no data is published to the blockchain network.
+//
+// The optional "stateOverrides" replace the balance, nonce, code, or storage of
+// accounts before execution, and the optional "blockOverrides" replace fields
+// of the block context (number, time, feeRecipient, baseFeePerGas).
 func (e *EthAPI) Call(args evm.JsonTxArgs,
 	blockNrOrHash rpc.BlockNumberOrHash,
-	_ *rpc.StateOverride,
+	stateOverrides *rpc.StateOverride,
+	blockOverrides *evm.BlockOverrides,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -300,7 +311,7 @@ func (e *EthAPI) Call(args evm.JsonTxArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, stateOverrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -308,6 +319,38 @@ func (e *EthAPI) Call(args evm.JsonTxArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes a series of calls across one or more simulated blocks on
+// top of the state at the given block (latest by default).
+//
+// The calls run in order on a single state, so each call observes the changes
+// of the calls before it, for example an "approve" followed by a "transferFrom".
+// Nothing is published to the blockchain network. Each block may set its own
+// state and block overrides, and "validation" enables the nonce, base fee and
+// balance checks of real transactions.
+func (e *EthAPI) SimulateV1(
+	opts evm.SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+) ([]rpc.SimBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpc.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+	res, err := e.backend.SimulateV1(opts, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]rpc.SimBlockResult, len(res.Blocks))
+	for i, block := range res.Blocks {
+		blocks[i] = rpc.NewSimBlockResult(block)
+	}
+	return blocks, nil
+}
+
 // --------------------------------------------------------------------------
 //                           Event Logs
 // --------------------------------------------------------------------------
@@ -330,11 +373,15 @@ func (e *EthAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
+// State and block overrides are applied the same way as in [EthAPI.Call].
 func (e *EthAPI) EstimateGas(
-	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+	args evm.JsonTxArgs,
+	blockNrOptional *rpc.BlockNumber,
+	stateOverrides *rpc.StateOverride,
+	blockOverrides *evm.BlockOverrides,
 ) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, stateOverrides, blockOverrides)
 }
 
 func (e *EthAPI) FeeHistory(blockCount gethmath.HexOrDecimal64,
@@ -471,39 +518,24 @@ func (e *EthAPI) FillTransaction(
 	}, nil
 }
 
-// GetPendingTransactions returns the transactions that are in the transaction
-// pool and have a from address that is one of the accounts this node manages.
+// GetPendingTransactions returns the eth transactions that are in the
+// transaction pool, in the order they are applied to the "pending" state. Block
+// fields are null because the transactions are not included in a block yet.
 func (e *EthAPI) GetPendingTransactions() ([]*rpc.EthTxJsonRPC, error) {
 	e.logger.Debug("eth_getPendingTransactions")
 
-	txs, err := e.backend.PendingTransactions()
+	pendingTxs, err := e.backend.PendingStateTxs()
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*rpc.EthTxJsonRPC, 0, len(txs))
-	for _, tx := range txs {
-		for _, msg := range (*tx).GetMsgs() {
-			ethMsg, ok := msg.(*evm.MsgEthereumTx)
-			if !ok {
-				// not valid ethereum tx
-				break
-			}
-
-			rpctx, err := rpc.NewRPCTxFromMsgEthTx(
-				ethMsg,
-				common.Hash{},
-				uint64(0),
-				uint64(0),
-				nil,
-				e.backend.ChainConfig().ChainID,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			result = append(result, rpctx)
+	result := make([]*rpc.EthTxJsonRPC, 0, len(pendingTxs))
+	for _, ethMsg := range pendingTxs {
+		rpctx, err := e.backend.TxPoolRPCTx(ethMsg)
+		if err != nil {
+			return nil, err
 		}
+		result = append(result, rpctx)
 	}
 
 	return result, nil
@@ -84,9 +84,11 @@ func (s *Suite) TestExpectedMethods() {
 		[]string{
 			rpcapi.NamespaceEth, // eth and filters services
 			rpcapi.NamespaceDebug,
+			rpcapi.NamespaceTrace,
+			rpcapi.NamespaceNibiru,
 		},
 	)
-	s.Require().Len(apis, 3)
+	s.Require().Len(apis, 5)
 	type WantMethod struct {
 		ServiceName string
 		Methods     []string
@@ -151,6 +153,22 @@ func (s *Suite) TestExpectedMethods() {
 				"debug_traceTransaction",
 			},
 		},
+		{
+			ServiceName: "rpcapi.TraceAPI",
+			Methods: []string{
+				"trace_block",
+				"trace_call",
+				"trace_filter",
+				"trace_replayTransaction",
+				"trace_transaction",
+			},
+		},
+		{
+			ServiceName: "rpcapi.NibiruAPI",
+			Methods: []string{
+				"nibiru_getTransactionsByAddress",
+			},
+		},
 	}
 
 	for idx, api := range apis {
@@ -142,18 +142,33 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*get
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	// Blocks covered by the log index of the EVM tx indexer are read from the
+	// index, which serves ranges of any size since it stops reading at the log
+	// limit. The block range cap only applies to the blocks after them, which
+	// are read one by one.
+	from := f.criteria.FromBlock.Int64()
+	if from <= head {
+		indexedLogs, indexedTo, err := f.backend.GetLogsFromIndexer(
+			from, min(f.criteria.ToBlock.Int64(), head), f.criteria.Addresses, f.criteria.Topics, logLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, indexedLogs...)
+		from = indexedTo + 1
+	}
+
+	if f.criteria.ToBlock.Int64()-from > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
 	// check bounds
-	if f.criteria.FromBlock.Int64() > head {
-		return []*gethcore.Log{}, nil
+	if from > head {
+		return logs, nil
 	} else if f.criteria.ToBlock.Int64() > head+maxToOverhang {
 		f.criteria.ToBlock = big.NewInt(head + maxToOverhang)
 	}
 
-	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
 	for height := from; height <= to; height++ {
@@ -0,0 +1,54 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+)
+
+// NibiruAPI implements the "nibiru" namespace, which serves Nibiru-specific
+// queries that have no equivalent in the Ethereum JSON-RPC spec.
+type NibiruAPI struct {
+	logger  log.Logger
+	backend *backend.Backend
+}
+
+// NewImplNibiruAPI creates a new API for the "nibiru" namespace.
+func NewImplNibiruAPI(logger log.Logger, backend *backend.Backend) *NibiruAPI {
+	return &NibiruAPI{
+		logger:  logger.With("module", "nibiru"),
+		backend: backend,
+	}
+}
+
+// GetTransactionsByAddress returns a page of the EVM transactions sent by or
+// to an address, in chain order. Recipients include the contracts created by
+// the transactions and the recipients of FunToken precompile transfers.
+//
+// The block range defaults to all blocks. "cursor" is omitted for the first
+// page and set to the "nextCursor" of the previous page for the following
+// ones. "limit" defaults to [backend.DefaultTxsByAddressLimit].
+//
+// This method requires the EVM tx indexer ("json-rpc.enable-indexer").
+func (api *NibiruAPI) GetTransactionsByAddress(
+	address gethcommon.Address,
+	fromBlock, toBlock *rpc.BlockNumber,
+	cursor *hexutil.Bytes,
+	limit *hexutil.Uint64,
+) (*rpc.TxsByAddressResult, error) {
+	api.logger.Debug("nibiru_getTransactionsByAddress", "address", address.Hex())
+	var cursorBz hexutil.Bytes
+	if cursor != nil {
+		cursorBz = *cursor
+	}
+	var pageLimit uint64
+	if limit != nil {
+		pageLimit = uint64(*limit)
+	}
+	return api.backend.GetTransactionsByAddress(address, fromBlock, toBlock, cursorBz, pageLimit)
+}
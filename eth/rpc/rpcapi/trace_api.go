@@ -0,0 +1,105 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// traceTypeTrace is the only trace type of "trace_call" and
+// "trace_replayTransaction" supported by the [TraceAPI].
+const traceTypeTrace = "trace"
+
+// TraceAPI implements the Parity/OpenEthereum "trace" namespace used by block
+// explorers and indexers.
+//
+// Transactions are traced with geth's native "callTracer" through the EVM
+// module's "TraceTx" and "TraceBlock" queries, and the nested call frames are
+// flattened into Parity traces. The "stateDiff" and "vmTrace" trace types are
+// not supported.
+type TraceAPI struct {
+	logger  log.Logger
+	backend *backend.Backend
+}
+
+// NewImplTraceAPI creates a new API for the "trace" namespace.
+func NewImplTraceAPI(logger log.Logger, backend *backend.Backend) *TraceAPI {
+	return &TraceAPI{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat traces of all EVM transactions in a block.
+func (api *TraceAPI) Block(blockNr rpc.BlockNumber) ([]rpc.ParityTrace, error) {
+	api.logger.Debug("trace_block", "block number", blockNr)
+	return api.backend.TraceBlockFlat(blockNr)
+}
+
+// Transaction returns the flat traces of a transaction.
+func (api *TraceAPI) Transaction(txHash gethcommon.Hash) ([]rpc.ParityTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", txHash.Hex())
+	return api.backend.TraceTransactionFlat(txHash)
+}
+
+// Filter returns the flat traces in a block range that match the given
+// sender and recipient addresses. The block range is bounded by the
+// "BlockRangeCap" of the JSON-RPC config.
+func (api *TraceAPI) Filter(args rpc.TraceFilterArgs) ([]rpc.ParityTrace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+	return api.backend.TraceFilter(args)
+}
+
+// ReplayTransaction replays a transaction and returns its output and flat
+// traces. Only the "trace" trace type is supported.
+func (api *TraceAPI) ReplayTransaction(
+	txHash gethcommon.Hash, traceTypes []string,
+) (*rpc.ParityTraceResults, error) {
+	api.logger.Debug("trace_replayTransaction", "hash", txHash.Hex(), "trace types", traceTypes)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	return api.backend.TraceReplayTransaction(txHash)
+}
+
+// Call executes a call on top of the state of the given block (latest by
+// default) and returns its output and flat traces. Only the "trace" trace
+// type is supported.
+func (api *TraceAPI) Call(
+	args evm.JsonTxArgs, traceTypes []string, blockNrOrHash *rpc.BlockNumberOrHash,
+) (*rpc.ParityTraceResults, error) {
+	api.logger.Debug("trace_call", "args", args.String(), "trace types", traceTypes)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+
+	blockNr := rpc.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNr, err = api.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return api.backend.TraceCallFlat(args, blockNr)
+}
+
+// validateTraceTypes returns an error if any of the requested trace types
+// is not supported.
+func validateTraceTypes(traceTypes []string) error {
+	for _, traceType := range traceTypes {
+		if traceType != traceTypeTrace {
+			return fmt.Errorf(
+				"unsupported trace type %q: only %q is supported", traceType, traceTypeTrace,
+			)
+		}
+	}
+	return nil
+}
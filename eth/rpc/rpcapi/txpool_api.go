@@ -2,23 +2,34 @@
 package rpcapi
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
 // TxPoolAPI offers and API for the transaction pool. It only operates on data
 // that is non-confidential.
+//
+// The pool content is read from the CometBFT mempool. Transactions are
+// reported as "pending" when they are executable against the current EVM
+// state and as "queued" when their nonce leaves a gap after the account nonce.
 type TxPoolAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend *backend.Backend
 }
 
 // NewImplTxPoolAPI creates a new tx pool service that gives information about the transaction pool.
-func NewImplTxPoolAPI(logger log.Logger) *TxPoolAPI {
+func NewImplTxPoolAPI(logger log.Logger, backend *backend.Backend) *TxPoolAPI {
 	return &TxPoolAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -31,24 +42,117 @@ func (api *TxPoolAPI) Content() (
 		"pending": make(map[string]map[string]*rpc.EthTxJsonRPC),
 		"queued":  make(map[string]map[string]*rpc.EthTxJsonRPC),
 	}
+
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	for sender, txs := range pool.Pending {
+		dump, err := api.rpcTxsByNonce(txs)
+		if err != nil {
+			return nil, err
+		}
+		content["pending"][sender.Hex()] = dump
+	}
+	for sender, txs := range pool.Queued {
+		dump, err := api.rpcTxsByNonce(txs)
+		if err != nil {
+			return nil, err
+		}
+		content["queued"][sender.Hex()] = dump
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent by the given address.
+func (api *TxPoolAPI) ContentFrom(
+	addr gethcommon.Address,
+) (map[string]map[string]*rpc.EthTxJsonRPC, error) {
+	api.logger.Debug("txpool_contentFrom", "address", addr.Hex())
+	pending, queued, err := api.backend.TxPoolContentFrom(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingDump, err := api.rpcTxsByNonce(pending)
+	if err != nil {
+		return nil, err
+	}
+	queuedDump, err := api.rpcTxsByNonce(queued)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]map[string]*rpc.EthTxJsonRPC{
+		"pending": pendingDump,
+		"queued":  queuedDump,
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *TxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	for sender, txs := range pool.Pending {
+		content["pending"][sender.Hex()] = inspectTxsByNonce(txs)
+	}
+	for sender, txs := range pool.Queued {
+		content["queued"][sender.Hex()] = inspectTxsByNonce(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *TxPoolAPI) Status() map[string]hexutil.Uint {
+func (api *TxPoolAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	var numPending, numQueued int
+	for _, txs := range pool.Pending {
+		numPending += len(txs)
+	}
+	for _, txs := range pool.Queued {
+		numQueued += len(txs)
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(numPending),
+		"queued":  hexutil.Uint(numQueued),
+	}, nil
+}
+
+// rpcTxsByNonce converts the txs of a single sender into their JSON-RPC
+// representation keyed by the decimal nonce.
+func (api *TxPoolAPI) rpcTxsByNonce(
+	txs map[uint64]*evm.MsgEthereumTx,
+) (map[string]*rpc.EthTxJsonRPC, error) {
+	dump := make(map[string]*rpc.EthTxJsonRPC, len(txs))
+	for nonce, msg := range txs {
+		rpcTx, err := api.backend.TxPoolRPCTx(msg)
+		if err != nil {
+			return nil, err
+		}
+		dump[fmt.Sprintf("%d", nonce)] = rpcTx
+	}
+	return dump, nil
+}
+
+// inspectTxsByNonce summarizes the txs of a single sender keyed by the decimal
+// nonce.
+func inspectTxsByNonce(txs map[uint64]*evm.MsgEthereumTx) map[string]string {
+	dump := make(map[string]string, len(txs))
+	for nonce, msg := range txs {
+		dump[fmt.Sprintf("%d", nonce)] = backend.TxPoolInspectSummary(msg)
 	}
+	return dump
 }
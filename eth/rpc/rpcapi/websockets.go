@@ -33,6 +33,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/pubsub"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi/debugapi"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
@@ -77,11 +78,15 @@ type websocketsServer struct {
 	logger   log.Logger
 }
 
+// NewWebsocketsServer creates the JSON-RPC websocket server. The optional
+// "debugAPI" serves the "debug_subscribe" subscriptions, such as
+// "traceChain". It is nil when the "debug" namespace is disabled.
 func NewWebsocketsServer(
 	clientCtx client.Context,
 	logger log.Logger,
 	tmWSClient *rpcclient.WSClient,
 	cfg *config.Config,
+	debugAPI *debugapi.DebugAPI,
 ) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
@@ -91,7 +96,7 @@ func NewWebsocketsServer(
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, debugAPI),
 		logger:   logger,
 	}
 }
@@ -261,7 +266,33 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			if err := wsConn.WriteJSON(res); err != nil {
 				break
 			}
-		case "eth_unsubscribe":
+		case "debug_subscribe":
+			params, ok := s.getParamsAndCheckValid(msg, wsConn)
+			if !ok {
+				continue
+			}
+
+			subID := gethrpc.NewID()
+			unsubFn, startFn, err := s.api.subscribeDebug(wsConn, subID, params)
+			if err != nil {
+				s.sendErrResponse(wsConn, err.Error())
+				continue
+			}
+			subscriptions[subID] = unsubFn
+
+			res := &SubscriptionResponseJSON{
+				Jsonrpc: "2.0",
+				ID:      connID,
+				Result:  subID,
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				break
+			}
+			// Notifications only start once the client knows the
+			// subscription ID.
+			startFn()
+		case "eth_unsubscribe", "debug_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -350,15 +381,22 @@ type pubSubAPI struct {
 	events    *EventSubscriber
 	logger    log.Logger
 	clientCtx client.Context
+	debugAPI  *debugapi.DebugAPI
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	debugAPI *debugapi.DebugAPI,
+) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    NewEventSubscriber(logger, tmWSClient),
 		logger:    logger,
 		clientCtx: clientCtx,
+		debugAPI:  debugAPI,
 	}
 }
 
@@ -689,6 +727,83 @@ func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ gethrpc.ID) (pubsub.Unsubscr
 	return nil, pkgerrors.New("syncing subscription is not implemented")
 }
 
+// subscribeDebug handles the "debug_subscribe" subscriptions. The returned
+// "start" function begins sending notifications and must be called after the
+// subscription ID is sent to the client.
+func (api *pubSubAPI) subscribeDebug(
+	wsConn *wsConn, subID gethrpc.ID, params []any,
+) (unsubscribe pubsub.UnsubscribeFunc, start func(), err error) {
+	method, ok := params[0].(string)
+	if !ok {
+		return nil, nil, pkgerrors.New("invalid parameters")
+	}
+	if api.debugAPI == nil {
+		return nil, nil, pkgerrors.New("the debug namespace is not enabled")
+	}
+
+	switch method {
+	case "traceChain":
+		return api.subscribeTraceChain(wsConn, subID, params[1:])
+	default:
+		return nil, nil, pkgerrors.Errorf("unsupported method %s", method)
+	}
+}
+
+// subscribeTraceChain streams the results of "debug_traceChain" with the
+// params [start, end, config]. Each traced block is sent as a separate
+// "debug_subscription" notification.
+func (api *pubSubAPI) subscribeTraceChain(
+	wsConn *wsConn, subID gethrpc.ID, params []any,
+) (unsubscribe pubsub.UnsubscribeFunc, start func(), err error) {
+	if len(params) < 2 {
+		return nil, nil, pkgerrors.New("traceChain requires a start and end block")
+	}
+	var (
+		startBlock, endBlock rpc.BlockNumber
+		config               *evm.TraceConfig
+	)
+	paramsBz, err := json.Marshal(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	args := []any{&startBlock, &endBlock, &config}
+	if err := json.Unmarshal(paramsBz, &args); err != nil {
+		return nil, nil, pkgerrors.Wrap(err, "invalid traceChain parameters")
+	}
+
+	from, to, err := api.debugAPI.TraceChainRange(startBlock, endBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stop := make(chan error)
+	start = func() {
+		go api.streamTraceChain(wsConn, subID, from, to, config, stop)
+	}
+	var once sync.Once
+	return func() { once.Do(func() { close(stop) }) }, start, nil
+}
+
+// streamTraceChain sends the traces of a "traceChain" subscription until the
+// last block is traced or "stop" is closed.
+func (api *pubSubAPI) streamTraceChain(
+	wsConn *wsConn, subID gethrpc.ID, from, to int64, config *evm.TraceConfig, stop <-chan error,
+) {
+	err := api.debugAPI.StreamTraceChain(from, to, config, stop, func(result *debugapi.BlockTraceResult) error {
+		return wsConn.WriteJSON(&SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "debug_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		})
+	})
+	if err != nil {
+		api.logger.Debug("dropping traceChain WebSocket subscription", "subscription-id", subID, "error", err.Error())
+	}
+}
+
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
 // isBatch returns true when the first non-whitespace characters is '['
 func isBatch(raw []byte) bool {
@@ -9,6 +9,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -23,6 +27,25 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+
+	// Fields below are specific to Nibiru. Nibiru has no Merkle-Patricia trie,
+	// so the account is proven with ICS23 proofs of the Cosmos SDK stores,
+	// which are checked with [eth.StoreProof.Verify].
+
+	// AppHashBlockNumber: Number of the block whose header has the app hash
+	// that the ICS23 proofs are verified against. It is the block after the
+	// requested one, since an app hash commits to the state after the parent
+	// block.
+	AppHashBlockNumber hexutil.Uint64 `json:"appHashBlockNumber"`
+	// AuthAccountProof: Proof of the account in the "x/auth" store, which holds
+	// the nonce and code hash.
+	AuthAccountProof eth.StoreProof `json:"authAccountProof"`
+	// BalanceProof: Proof of the balance of the EVM denom in the "x/bank"
+	// store, in units of the bank coin rather than wei.
+	BalanceProof eth.StoreProof `json:"balanceProof"`
+	// CodeProof: Proof of the contract bytecode in the "x/evm" store. It is
+	// nil for accounts without code.
+	CodeProof *eth.StoreProof `json:"codeProof,omitempty"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -30,6 +53,10 @@ type StorageResult struct {
 	Key   string       `json:"key"`
 	Value *hexutil.Big `json:"value"`
 	Proof []string     `json:"proof"`
+
+	// StoreProof: ICS23 proof of the storage slot in the "x/evm" store. Slots
+	// with a zero value are not stored and have a proof of absence.
+	StoreProof eth.StoreProof `json:"storeProof"`
 }
 
 // EthTxJsonRPC represents a transaction that will serialize to the RPC representation of a transaction
@@ -55,21 +82,105 @@ type EthTxJsonRPC struct {
 	S                *hexutil.Big         `json:"s"`
 }
 
+// TxsByAddressResult is a page of the transaction history of an account
+// returned by "nibiru_getTransactionsByAddress".
+type TxsByAddressResult struct {
+	// Transactions: EVM txs sent by or to the account, in chain order.
+	Transactions []*EthTxJsonRPC `json:"transactions"`
+	// NextCursor: Cursor of the next page, or null for the last page.
+	NextCursor *hexutil.Bytes `json:"nextCursor"`
+}
+
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evm.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+// a message call. See [evm.OverrideAccount].
+type OverrideAccount = evm.OverrideAccount
+
+// SimBlockResult is the result of a simulated block in "eth_simulateV1".
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	FeeRecipient  common.Address  `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call in "eth_simulateV1".
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*gethcore.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed call in "eth_simulateV1". A reverted
+// call has code [SimErrCodeReverted] and carries the revert data, while other
+// EVM errors have code [SimErrCodeVMError].
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+const (
+	// SimErrCodeReverted is the error code of a reverted call in
+	// "eth_simulateV1", shared with reverted "eth_call"s.
+	SimErrCodeReverted = 3
+	// SimErrCodeVMError is the error code of a call that failed with an EVM
+	// error other than a revert in "eth_simulateV1".
+	SimErrCodeVMError = -32015
+)
+
+// NewSimBlockResult converts a [evm.SimulatedBlock] into its JSON-RPC
+// representation.
+func NewSimBlockResult(block evm.SimulatedBlock) SimBlockResult {
+	res := SimBlockResult{
+		Number:        hexutil.Uint64(block.Number),
+		Timestamp:     hexutil.Uint64(block.Timestamp),
+		GasLimit:      hexutil.Uint64(block.GasLimit),
+		GasUsed:       hexutil.Uint64(block.GasUsed),
+		FeeRecipient:  common.HexToAddress(block.FeeRecipient),
+		BaseFeePerGas: (*hexutil.Big)(block.BaseFee.BigInt()),
+		Calls:         make([]SimCallResult, len(block.Calls)),
+	}
+	for i, call := range block.Calls {
+		callRes := SimCallResult{
+			ReturnValue: call.ReturnData,
+			Logs:        evm.LogsToEthereum(call.Logs),
+			GasUsed:     hexutil.Uint64(call.GasUsed),
+			Status:      hexutil.Uint64(gethcore.ReceiptStatusSuccessful),
+		}
+		switch {
+		case call.VmError == "":
+		case call.VmError == vm.ErrExecutionReverted.Error():
+			callRes.Status = hexutil.Uint64(gethcore.ReceiptStatusFailed)
+			message := call.VmError
+			if call.RevertReason != "" {
+				message += ": " + call.RevertReason
+			}
+			callRes.Error = &SimCallError{
+				Code:    SimErrCodeReverted,
+				Message: message,
+				Data:    hexutil.Encode(call.ReturnData),
+			}
+		default:
+			callRes.Status = hexutil.Uint64(gethcore.ReceiptStatusFailed)
+			callRes.Error = &SimCallError{
+				Code:    SimErrCodeVMError,
+				Message: call.VmError,
+			}
+		}
+		if callRes.Logs == nil {
+			callRes.Logs = []*gethcore.Log{}
+		}
+		res.Calls[i] = callRes
+	}
+	return res
 }
 
 type FeeHistoryResult struct {
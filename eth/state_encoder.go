@@ -24,6 +24,8 @@ var (
 
 	// keEthHash: Implements a `collections.KeyEncoder` for an Ethereum hash.
 	KeyEncoderEthHash collections.KeyEncoder[gethcommon.Hash] = keEthHash{}
+	// Implements a `collections.ValueEncoder` for an Ethereum hash.
+	ValueEncoderEthHash collections.ValueEncoder[gethcommon.Hash] = veEthHash{}
 )
 
 // collections ValueEncoder[[]byte]
@@ -42,6 +44,14 @@ func (_ veEthAddr) Decode(bz []byte) gethcommon.Address       { return gethcommo
 func (_ veEthAddr) Stringify(value gethcommon.Address) string { return value.Hex() }
 func (_ veEthAddr) Name() string                              { return "gethcommon.Address" }
 
+// veEthHash: Implements a `collections.ValueEncoder` for an Ethereum hash.
+type veEthHash struct{}
+
+func (_ veEthHash) Encode(value gethcommon.Hash) []byte    { return value.Bytes() }
+func (_ veEthHash) Decode(bz []byte) gethcommon.Hash       { return gethcommon.BytesToHash(bz) }
+func (_ veEthHash) Stringify(value gethcommon.Hash) string { return value.Hex() }
+func (_ veEthHash) Name() string                           { return "gethcommon.Hash" }
+
 type keBytes struct{}
 
 // Encode encodes the type T into bytes.
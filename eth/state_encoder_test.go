@@ -86,3 +86,13 @@ func (s *Suite) TestEncoderEthAddr() {
 		})
 	}
 }
+
+func (s *Suite) TestEncoderEthHash() {
+	for _, given := range []gethcommon.Hash{
+		{},
+		gethcommon.HexToHash("0x8b1c4e6e5c1f1f7b2b0b2d7e0f6e2a5f0c8a2c6f3b1d9e0a7c4b2e1f0d3c5a69"),
+	} {
+		assertBijectiveKey(s.T(), eth.KeyEncoderEthHash, given)
+		assertBijectiveValue(s.T(), eth.ValueEncoderEthHash, given)
+	}
+}
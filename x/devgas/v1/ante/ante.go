@@ -9,7 +9,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	devgastypes "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
 )
 
@@ -66,12 +68,41 @@ func (a DevGasPayoutDecorator) devGasPayout(
 		return err
 	}
 
+	return a.payout(ctx, toPay, params, tx.GetFee())
+}
+
+// PayoutEvmDevGas pays the developer share of the gas fees of an Ethereum tx
+// to the withdrawer of the EVM contract it called, provided the contract is
+// registered. Unlike the payouts of the ante handler, it runs after the EVM
+// execution, so "feesPaid" is the fee for the gas used rather than the gas
+// wanted.
+func (a DevGasPayoutDecorator) PayoutEvmDevGas(
+	ctx sdk.Context, contract gethcommon.Address, feesPaid sdk.Coins,
+) error {
+	params := a.devgasKeeper.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil
+	}
+
+	shareData, _ := a.devgasKeeper.GetFeeShare(ctx, eth.EthAddrToNibiruAddr(contract))
+	withdrawAddr := shareData.GetWithdrawerAddr()
+	if withdrawAddr == nil || withdrawAddr.Empty() {
+		return nil
+	}
+	return a.payout(ctx, []sdk.AccAddress{withdrawAddr}, params, feesPaid)
+}
+
+// payout settles the fee payments to the withdraw addresses and emits an
+// event with the amounts paid.
+func (a DevGasPayoutDecorator) payout(
+	ctx sdk.Context, toPay []sdk.AccAddress, params devgastypes.ModuleParams, fees sdk.Coins,
+) error {
 	// Do nothing if no one needs payment
 	if len(toPay) == 0 {
 		return nil
 	}
 
-	feesPaidOutput, err := a.settleFeePayments(ctx, toPay, params, tx.GetFee())
+	feesPaidOutput, err := a.settleFeePayments(ctx, toPay, params, fees)
 	if err != nil {
 		return err
 	}
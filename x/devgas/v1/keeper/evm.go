@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+// gasPerDeployerNonce is the gas consumed for each nonce of the deployer
+// checked by [Keeper.GetEvmContractDeployer].
+const gasPerDeployerNonce uint64 = 50
+
+// isEvmContract returns true if the address holds the bytecode of an EVM
+// contract.
+func (k Keeper) isEvmContract(ctx sdk.Context, contract sdk.AccAddress) bool {
+	acc := k.evmKeeper.GetAccount(ctx, eth.NibiruAddrToEthAddr(contract))
+	return acc != nil && acc.IsContract()
+}
+
+// GetEvmContractDeployer ensures that the deployer created the EVM contract
+// and returns the address of the deployer. EVM state does not store the
+// creator of a contract, so this checks whether the contract address is the
+// CREATE address of the deployer for one of the nonces it has used.
+//
+// Contracts deployed with CREATE2 or by other contracts cannot be verified
+// this way, and so cannot be registered.
+func (k Keeper) GetEvmContractDeployer(
+	ctx sdk.Context, contract sdk.AccAddress, deployer string,
+) (sdk.AccAddress, error) {
+	deployerAddr, err := sdk.AccAddressFromBech32(deployer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid deployer address %s", deployer)
+	}
+
+	contractEthAddr := eth.NibiruAddrToEthAddr(contract)
+	deployerEthAddr := eth.NibiruAddrToEthAddr(deployerAddr)
+	nonce := k.evmKeeper.GetAccNonce(ctx, deployerEthAddr)
+	for n := uint64(0); n < nonce; n++ {
+		ctx.GasMeter().ConsumeGas(gasPerDeployerNonce, "devgas: check EVM deployer nonce")
+		if crypto.CreateAddress(deployerEthAddr, n) == contractEthAddr {
+			return deployerAddr, nil
+		}
+	}
+	return nil, sdkerrors.ErrUnauthorized.Wrapf(
+		"you are not the creator of the EVM contract %s", contractEthAddr.Hex(),
+	)
+}
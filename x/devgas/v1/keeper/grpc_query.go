@@ -53,12 +53,11 @@ func (q Querier) FeeShare(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check if the contract is a non-zero hex address
-	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	contract, err := types.ParseContractAddr(req.ContractAddress)
 	if err != nil {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
-			"invalid format for contract %s, should be bech32 ('nibi...')", req.ContractAddress,
+			"invalid format for contract %s, should be bech32 ('nibi...') or hex ('0x...')", req.ContractAddress,
 		)
 	}
 
@@ -19,9 +19,15 @@ var _ types.MsgServer = &Keeper{}
 // (1) the info.Admin is the gov module
 // (2) the info.Creator is another smart contract
 // (3) the info.Admin is another contract
+//
+// EVM contracts have no contract info and are never considered to be created
+// from the "factory".
 func (k Keeper) isContractCreatedFromFactory(
 	ctx sdk.Context, info *wasmTypes.ContractInfo, msgSender sdk.AccAddress,
 ) bool {
+	if info == nil {
+		return false
+	}
 	govMod := k.accountKeeper.GetModuleAddress(govtypes.ModuleName).String()
 	switch {
 	case info.Admin == govMod:
@@ -51,6 +57,8 @@ func (k Keeper) isContractCreatedFromFactory(
 
 // GetContractAdminOrCreatorAddress ensures the deployer is the contract's
 // admin OR creator if no admin is set for all msg_server feeshare functions.
+// For EVM contracts, the deployer must be the account that created the
+// contract (see [Keeper.GetEvmContractDeployer]).
 func (k Keeper) GetContractAdminOrCreatorAddress(
 	ctx sdk.Context, contract sdk.AccAddress, deployer string,
 ) (sdk.AccAddress, error) {
@@ -62,6 +70,9 @@ func (k Keeper) GetContractAdminOrCreatorAddress(
 
 	// Retrieve contract info
 	info := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if info == nil && k.isEvmContract(ctx, contract) {
+		return k.GetEvmContractDeployer(ctx, contract, deployer)
+	}
 	if info == nil {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf(
 			"contract with address %s not found in state", contract,
@@ -118,7 +129,7 @@ func (k Keeper) RegisterFeeShare(
 	}
 
 	// Get Contract
-	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	contract, err := types.ParseContractAddr(msg.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address (%s)", err)
 	}
@@ -202,7 +213,7 @@ func (k Keeper) UpdateFeeShare(
 		return nil, types.ErrFeeShareDisabled
 	}
 
-	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	contract, err := types.ParseContractAddr(msg.ContractAddress)
 	if err != nil {
 		return nil,
 			sdkerrors.ErrInvalidAddress.Wrapf(
@@ -263,7 +274,7 @@ func (k Keeper) CancelFeeShare(
 		return nil, types.ErrFeeShareDisabled
 	}
 
-	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	contract, err := types.ParseContractAddr(msg.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address (%s)", err)
 	}
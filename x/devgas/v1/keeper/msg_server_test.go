@@ -15,7 +15,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 )
 
 //go:embed testdata/reflect.wasm
@@ -246,6 +250,76 @@ func (s *KeeperTestSuite) TestRegisterFeeShare() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRegisterFeeShareEvmContract() {
+	deps := evmtest.TestDeps{
+		App:       s.app,
+		Ctx:       s.ctx,
+		EvmKeeper: s.app.EvmKeeper,
+		GenState:  evm.DefaultGenesisState(),
+		Sender:    evmtest.NewEthPrivAcc(),
+	}
+	s.Require().NoError(s.FundAccount(
+		s.ctx, deps.Sender.NibiruAddr, sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdkmath.NewInt(1_000_000_000))),
+	))
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	contractAddr := deployResp.ContractAddr
+
+	_, _, withdrawer := testdata.KeyTestPubAddr()
+	otherAcc := evmtest.NewEthPrivAcc()
+
+	for _, tc := range []struct {
+		desc      string
+		msg       *types.MsgRegisterFeeShare
+		shouldErr bool
+	}{
+		{
+			desc: "Not the deployer of the EVM contract",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   contractAddr.Hex(),
+				DeployerAddress:   otherAcc.NibiruAddr.String(),
+				WithdrawerAddress: withdrawer.String(),
+			},
+			shouldErr: true,
+		},
+		{
+			desc: "EVM account that is not a contract",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   otherAcc.EthAddr.Hex(),
+				DeployerAddress:   deps.Sender.NibiruAddr.String(),
+				WithdrawerAddress: withdrawer.String(),
+			},
+			shouldErr: true,
+		},
+		{
+			desc: "Success with the hex address of the EVM contract",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   contractAddr.Hex(),
+				DeployerAddress:   deps.Sender.NibiruAddr.String(),
+				WithdrawerAddress: withdrawer.String(),
+			},
+			shouldErr: false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			goCtx := sdk.WrapSDKContext(s.ctx)
+			s.Require().NoError(tc.msg.ValidateBasic())
+			_, err := s.devgasMsgServer.RegisterFeeShare(goCtx, tc.msg)
+			if tc.shouldErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	feeShare, found := s.app.DevGasKeeper.GetFeeShare(s.ctx, eth.EthAddrToNibiruAddr(contractAddr))
+	s.Require().True(found)
+	s.Equal(withdrawer.String(), feeShare.WithdrawerAddress)
+	s.Equal(deps.Sender.NibiruAddr.String(), feeShare.DeployerAddress)
+}
+
 func (s *KeeperTestSuite) TestUpdateFeeShare() {
 	_, _, sender := testdata.KeyTestPubAddr()
 	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
@@ -5,8 +5,20 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
 )
 
+// ParseContractAddr parses the address of a Wasm or EVM contract. Besides the
+// Bech32 address, EVM contracts may be given by their hex address (0x...).
+func ParseContractAddr(contract string) (sdk.AccAddress, error) {
+	if gethcommon.IsHexAddress(contract) {
+		return eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(contract)), nil
+	}
+	return sdk.AccAddressFromBech32(contract)
+}
+
 // NewFeeShare returns an instance of FeeShare.
 func NewFeeShare(contract sdk.Address, deployer, withdrawer sdk.AccAddress) FeeShare {
 	return FeeShare{
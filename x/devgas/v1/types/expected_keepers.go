@@ -6,6 +6,9 @@ import (
 	// "github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acctypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
 
 // AccountKeeper defines the expected interface needed to retrieve account info.
@@ -30,3 +33,10 @@ type BankKeeper interface {
 type WasmKeeper interface {
 	GetContractInfo(ctx sdk.Context, contractAddr sdk.AccAddress) (wasmtypes.ContractInfo, error)
 }
+
+// EvmKeeper defines the expected interface needed to verify the deployers of
+// EVM contracts.
+type EvmKeeper interface {
+	GetAccount(ctx sdk.Context, addr gethcommon.Address) *statedb.Account
+	GetAccNonce(ctx sdk.Context, addr gethcommon.Address) uint64
+}
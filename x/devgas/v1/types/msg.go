@@ -48,7 +48,7 @@ func (msg MsgRegisterFeeShare) ValidateBasic() error {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+	if _, err := ParseContractAddr(msg.ContractAddress); err != nil {
 		return sdkioerrors.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
@@ -95,7 +95,7 @@ func (msg MsgCancelFeeShare) ValidateBasic() error {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+	if _, err := ParseContractAddr(msg.ContractAddress); err != nil {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
@@ -138,7 +138,7 @@ func (msg MsgUpdateFeeShare) ValidateBasic() error {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+	if _, err := ParseContractAddr(msg.ContractAddress); err != nil {
 		return sdkioerrors.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
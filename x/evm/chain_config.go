@@ -31,8 +31,10 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		MergeNetsplitBlock:  Big0,
 		// Shanghai switch time (nil = no fork, 0 => already on shanghai)
 		ShanghaiTime: ptrU64(0),
-		// CancunTime switch time (nil = no fork, 0 => already on cancun)
-		CancunTime:              nil, // nil => disable "blobs"
+		// CancunTime switch time (nil = no fork, 0 => already on cancun).
+		// Cancun is activated by the "enable_cancun" module parameter. See
+		// [Params.EthereumConfig].
+		CancunTime:              nil,
 		PragueTime:              nil, // nil => disable EIP-7702, blob improvements, and increased CALL gas costs
 		VerkleTime:              nil, // nil => disable stateless verification
 		TerminalTotalDifficulty: nil,
@@ -41,6 +43,17 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
+// EthereumConfig returns the Ethereum ChainConfig for EVM state transitions
+// with the hard forks that are switched on by the module parameters. Blob
+// transactions stay unsupported even when Cancun is active.
+func (p Params) EthereumConfig(chainID *big.Int) *params.ChainConfig {
+	cfg := EthereumConfig(chainID)
+	if p.EnableCancun {
+		cfg.CancunTime = ptrU64(0)
+	}
+	return cfg
+}
+
 func ptrU64(n uint) *uint64 {
 	u64 := uint64(n)
 	return &u64
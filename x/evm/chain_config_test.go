@@ -1,8 +1,11 @@
 package evm
 
 import (
+	"math/big"
+	"slices"
 	"testing"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -10,3 +13,15 @@ func TestChainConfigValidate(t *testing.T) {
 	err := Validate()
 	require.NoError(t, err)
 }
+
+func TestActivePrecompileAddrs(t *testing.T) {
+	kzgPointEvaluation := gethcommon.BytesToAddress([]byte{0x0a})
+	funToken := gethcommon.HexToAddress("0x0000000000000000000000000000000000000800")
+	for _, enableCancun := range []bool{true, false} {
+		params := DefaultParams()
+		params.EnableCancun = enableCancun
+		addrs := ActivePrecompileAddrs(params.EthereumConfig(big.NewInt(1)))
+		require.Equal(t, enableCancun, slices.Contains(addrs, kzgPointEvaluation))
+		require.True(t, slices.Contains(addrs, funToken))
+	}
+}
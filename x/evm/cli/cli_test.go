@@ -49,6 +49,48 @@ func (s *Suite) TestCmdConvertCoinToEvm() {
 	}
 }
 
+func (s *Suite) TestCmdConvertEvmToCoin() {
+	testCases := []TestCase{
+		{
+			name: "happy: convert-evm-to-coin",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.String(),
+				"123",
+				s.testAcc.Address.String(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "sad: amount format",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.String(),
+				"12.3",
+				s.testAcc.Address.String(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid amount",
+		},
+		{
+			name: "sad: to_bank_addr format",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.String(),
+				"123",
+				dummyEthAddr,
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "decoding bech32 failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
 func (s *Suite) TestCmdCreateFunToken() {
 	testCases := []TestCase{
 		{
@@ -171,3 +213,37 @@ func (s *Suite) TestCmdQueryFunToken() {
 		tc.RunQueryCmd(s)
 	}
 }
+
+func (s *Suite) TestCmdQueryFunTokens() {
+	testCases := []TestCase{
+		{
+			name:    "happy: query funtokens",
+			args:    []string{"funtokens"},
+			wantErr: "",
+		},
+		{
+			name: "happy: query funtokens with filters and pagination",
+			args: []string{
+				"funtokens",
+				"--origin=coin",
+				"--bank-denom-prefix=ibc/",
+				"--limit=10",
+			},
+			wantErr: "",
+		},
+		{
+			name:    "sad: invalid origin",
+			args:    []string{"funtokens", "--origin=wasm"},
+			wantErr: "invalid --origin",
+		},
+		{
+			name:    "sad: too many args",
+			args:    []string{"funtokens", "arg1"},
+			wantErr: "unknown command",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunQueryCmd(s)
+	}
+}
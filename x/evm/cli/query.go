@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQueryFunToken(),
+		CmdQueryFunTokens(),
 		CmdQueryAccount(),
 	}
 	for _, cmd := range cmds {
@@ -75,6 +76,86 @@ $ %s query %s get-fun-token 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
 	return cmd
 }
 
+// CmdQueryFunTokens lists the fungible token mappings with pagination
+func CmdQueryFunTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtokens [flags]",
+		Short: "Query all evm fungible token mappings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all evm fungible token mappings with pagination.
+
+Examples:
+$ %s query %s funtokens
+$ %s query %s funtokens --origin=coin --limit=10
+$ %s query %s funtokens --bank-denom-prefix=ibc/ --count-total
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			originFlag, err := cmd.Flags().GetString(flagOrigin)
+			if err != nil {
+				return err
+			}
+			origin, err := parseFunTokenOrigin(originFlag)
+			if err != nil {
+				return err
+			}
+			bankDenomPrefix, err := cmd.Flags().GetString(flagBankDenomPrefix)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FunTokenMappings(cmd.Context(), &evm.QueryFunTokenMappingsRequest{
+				Pagination:      pageReq,
+				Origin:          origin,
+				BankDenomPrefix: bankDenomPrefix,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funtokens")
+	cmd.Flags().String(flagOrigin, "", `Only list mappings created from a bank coin ("coin") or from an ERC20 ("erc20")`)
+	cmd.Flags().String(flagBankDenomPrefix, "", "Only list mappings with a bank denom that starts with the given prefix")
+	return cmd
+}
+
+const (
+	flagOrigin          = "origin"
+	flagBankDenomPrefix = "bank-denom-prefix"
+)
+
+// parseFunTokenOrigin parses the value of the "--origin" flag.
+func parseFunTokenOrigin(origin string) (evm.FunTokenOrigin, error) {
+	switch strings.ToLower(origin) {
+	case "":
+		return evm.FunTokenOrigin_FUN_TOKEN_ORIGIN_UNSPECIFIED, nil
+	case "coin":
+		return evm.FunTokenOrigin_FUN_TOKEN_ORIGIN_COIN, nil
+	case "erc20":
+		return evm.FunTokenOrigin_FUN_TOKEN_ORIGIN_ERC20, nil
+	default:
+		return 0, fmt.Errorf(`invalid --%s "%s": expected "coin" or "erc20"`, flagOrigin, origin)
+	}
+}
+
 func CmdQueryAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account [address]",
@@ -4,6 +4,7 @@ package evm
 import (
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,31 +12,64 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core"
 	gethvm "github.com/ethereum/go-ethereum/core/vm"
+	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
 )
 
-// BASE_FEE_MICRONIBI is the global base fee value for the network. It has a
-// constant value of 1 unibi (micronibi) == 10^12 wei.
+// BASE_FEE_MICRONIBI is the initial base fee value for the network, 1 unibi
+// (micronibi) == 10^12 wei. It is the default floor of the base fee, which
+// otherwise adjusts to congestion (see [Params.NextBaseFee]).
 var (
 	BASE_FEE_MICRONIBI = big.NewInt(1)
 	BASE_FEE_WEI       = NativeToWei(BASE_FEE_MICRONIBI)
 )
 
-var PRECOMPILE_ADDRS []gethcommon.Address =
-// Using a set cleanly removes potential duplicates
-set.New[gethcommon.Address](
-	append(gethvm.PrecompiledAddressesBerlin, []gethcommon.Address{
-		// FunToken 0x...800
-		gethcommon.HexToAddress("0x0000000000000000000000000000000000000800"),
-		// Wasm 0x...802
-		gethcommon.HexToAddress("0x0000000000000000000000000000000000000802"),
-		// Oracle 0x...801
-		gethcommon.HexToAddress("0x0000000000000000000000000000000000000801"),
-	}...)...,
+// PRECOMPILE_ADDRS is the set of addresses of every precompile that the Nibiru
+// EVM can run: the Ethereum precompiles up to Cancun and Nibiru's custom
+// precompiles. The KZG point evaluation precompile (0x0a) is only active if
+// Cancun is enabled. See [ActivePrecompileAddrs].
+var PRECOMPILE_ADDRS []gethcommon.Address = set.New[gethcommon.Address](
+	slices.Concat(gethvm.PrecompiledAddressesCancun, customPrecompileAddrs)...,
 ).ToSlice()
 
+// precompileAddrsBerlin is [PRECOMPILE_ADDRS] without the Ethereum precompiles
+// introduced by Cancun.
+var precompileAddrsBerlin []gethcommon.Address = set.New[gethcommon.Address](
+	slices.Concat(gethvm.PrecompiledAddressesBerlin, customPrecompileAddrs)...,
+).ToSlice()
+
+var customPrecompileAddrs = []gethcommon.Address{
+	// FunToken 0x...800
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000800"),
+	// Wasm 0x...802
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000802"),
+	// Oracle 0x...801
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000801"),
+	// Staking 0x...803
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000803"),
+	// ICS20 0x...804
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000804"),
+	// Governance 0x...805
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000805"),
+	// P256Verify 0x...100 (RIP-7212)
+	gethcommon.HexToAddress("0x0000000000000000000000000000000000000100"),
+	// Block hash history (EIP-2935)
+	gethcommon.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935"),
+}
+
+// ActivePrecompileAddrs returns the addresses of the precompiles that the EVM
+// runs with the given chain config. These are [PRECOMPILE_ADDRS], without the
+// KZG point evaluation precompile (0x0a) if Cancun is off, which is set by the
+// "enable_cancun" parameter. See [Params.EthereumConfig].
+func ActivePrecompileAddrs(cfg *gethparams.ChainConfig) []gethcommon.Address {
+	if cfg.CancunTime != nil {
+		return PRECOMPILE_ADDRS
+	}
+	return precompileAddrsBerlin
+}
+
 const (
 	// ModuleName string name of module
 	ModuleName = "evm"
@@ -65,8 +99,19 @@ const (
 	KeyPrefixFunTokenIdxErc20
 	// KV store prefix for indexing `FunToken` by bank coin denomination
 	KeyPrefixFunTokenIdxBankDenom
+	// KV store prefix for the EIP-1559 base fee of the next block
+	KeyPrefixBaseFee
+	// KV store prefix for in-flight ICS-20 transfers sent from ERC20 tokens
+	KeyPrefixIBCTransferRefunds
+	// KV store prefix for the ring buffer of recent block hashes
+	KeyPrefixBlockHashes
 )
 
+// BlockHashHistoryServeWindow is the number of recent block hashes kept in
+// state, as in EIP-2935. The hash of block "n" is stored in the ring buffer
+// slot "n % BlockHashHistoryServeWindow".
+const BlockHashHistoryServeWindow uint64 = 8191
+
 // KVStore transient prefix namespaces for the EVM Module. Transient stores only
 // remain for current block, and have more gas efficient read and write access.
 const (
@@ -88,6 +133,11 @@ func StateKey(address gethcommon.Address, key []byte) []byte {
 	return append(PrefixAccStateEthAddr(address), key...)
 }
 
+// CodeKey defines the full key under which contract bytecode is stored.
+func CodeKey(codeHash []byte) []byte {
+	return append(KeyPrefixAccCodes.Prefix(), codeHash...)
+}
+
 const (
 	// Amino names
 	updateParamsName = "evm/MsgUpdateParams"
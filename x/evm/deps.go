@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // AccountKeeper defines the expected account keeper interface
@@ -36,3 +37,9 @@ type StakingKeeper interface {
 	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
 }
+
+// DevGasPayout pays the developer share of the gas fees of Ethereum txs to
+// contracts registered with the "x/devgas" module.
+type DevGasPayout interface {
+	PayoutEvmDevGas(ctx sdk.Context, contract gethcommon.Address, feesPaid sdk.Coins) error
+}
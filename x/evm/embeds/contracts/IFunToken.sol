@@ -30,6 +30,18 @@ interface IFunToken is INibiruEvm {
         string memory bankDenom
     ) external view returns (address erc20Address);
 
+    /// @notice Method "listFunTokens" returns a page of the FunToken mappings,
+    /// ordered by their IDs.
+    /// @param offset Number of FunToken mappings to skip.
+    /// @param limit Maximum number of FunToken mappings to return. Values of
+    /// zero or above 50 use a limit of 50.
+    /// @return funTokens The FunToken mappings of the page.
+    /// @return total Total number of FunToken mappings.
+    function listFunTokens(
+        uint256 offset,
+        uint256 limit
+    ) external view returns (FunToken[] memory funTokens, uint256 total);
+
     struct NibiruAccount {
         address ethAddr;
         string bech32Addr;
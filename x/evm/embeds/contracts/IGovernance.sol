@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant GOVERNANCE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+IGovernance constant GOVERNANCE_PRECOMPILE = IGovernance(
+    GOVERNANCE_PRECOMPILE_ADDRESS
+);
+
+import "./NibiruEvmUtils.sol";
+
+/// @notice Implements chain governance from the EVM using the "x/gov" module.
+/// The caller (msg.sender) is the voter or depositor, so contracts holding
+/// staked NIBI, such as DAOs, can take part in governance.
+interface IGovernance is INibiruEvm {
+    /// @notice Vote option with a weight, used for split votes.
+    struct WeightedVoteOption {
+        /// @dev 1 = yes, 2 = abstain, 3 = no, 4 = no with veto
+        uint8 option;
+        /// @dev Fixed point number with 18 decimals. The weights of a vote
+        /// must add up to 1.
+        uint256 weight;
+    }
+
+    struct Proposal {
+        uint64 id;
+        /// @dev 1 = deposit period, 2 = voting period, 3 = passed,
+        /// 4 = rejected, 5 = failed
+        uint8 status;
+        /// @dev Bech32 address of the account that submitted the proposal
+        string proposer;
+        string title;
+        string summary;
+        string metadata;
+        INibiruEvm.BankCoin[] totalDeposit;
+        /// @dev Times are in Unix seconds, or zero if not yet known.
+        int64 submitTime;
+        int64 depositEndTime;
+        int64 votingStartTime;
+        int64 votingEndTime;
+    }
+
+    /// @notice Voting power of each vote option, in units of the staking bond
+    /// denomination ("unibi").
+    struct TallyResult {
+        uint256 yes;
+        uint256 abstain;
+        uint256 no;
+        uint256 noWithVeto;
+    }
+
+    /// @notice Votes on a proposal in the voting period with the staked
+    /// tokens of the caller. Voting again replaces the previous vote.
+    /// @param proposalId ID of the proposal
+    /// @param option 1 = yes, 2 = abstain, 3 = no, 4 = no with veto
+    /// @param metadata Optional metadata of the vote
+    /// @return success True if the vote succeeded
+    function vote(
+        uint64 proposalId,
+        uint8 option,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @notice Votes on a proposal in the voting period, splitting the staked
+    /// tokens of the caller between several options.
+    /// @param proposalId ID of the proposal
+    /// @param options Vote options with weights that add up to 1
+    /// @param metadata Optional metadata of the vote
+    /// @return success True if the vote succeeded
+    function voteWeighted(
+        uint64 proposalId,
+        WeightedVoteOption[] memory options,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @notice Deposits coins of the caller to a proposal in the deposit or
+    /// voting period.
+    /// @param proposalId ID of the proposal
+    /// @param amount Coins to deposit
+    /// @return success True if the deposit succeeded
+    function deposit(
+        uint64 proposalId,
+        INibiruEvm.BankCoin[] memory amount
+    ) external returns (bool success);
+
+    /// @notice Queries a proposal by its ID.
+    /// @param proposalId ID of the proposal
+    function getProposal(
+        uint64 proposalId
+    ) external view returns (Proposal memory);
+
+    /// @notice Queries the tally of a proposal. For proposals in the voting
+    /// period, this is the tally of the current votes. For finished proposals,
+    /// this is the final tally.
+    /// @param proposalId ID of the proposal
+    function getTally(
+        uint64 proposalId
+    ) external view returns (TallyResult memory);
+
+    /// @notice Queries the vote of an account on a proposal. The result is
+    /// empty if the account has not voted. Votes are deleted once the voting
+    /// period ends.
+    /// @param proposalId ID of the proposal
+    /// @param voter Address of the voter
+    /// @return options Vote options of the voter with their weights
+    function getVote(
+        uint64 proposalId,
+        address voter
+    ) external view returns (WeightedVoteOption[] memory options);
+}
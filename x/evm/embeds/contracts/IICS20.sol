@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant ICS20_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+IICS20 constant ICS20_PRECOMPILE = IICS20(ICS20_PRECOMPILE_ADDRESS);
+
+import "./NibiruEvmUtils.sol";
+
+/// @notice Implements ICS-20 fungible token transfers over IBC from the EVM.
+/// The caller (msg.sender) is the sender of the transfer.
+interface IICS20 is INibiruEvm {
+    /// @notice IBC height of the counterparty chain.
+    struct Height {
+        uint64 revisionNumber;
+        uint64 revisionHeight;
+    }
+
+    /// @notice Sends tokens to an account on another chain with an ICS-20
+    /// transfer.
+    ///
+    /// Tokens can be given as a bank coin denomination or as the address of a
+    /// FunToken ERC20. ERC20 tokens are first converted to bank coins of the
+    /// caller, as with "IFunToken.sendToBank". If the transfer times out or
+    /// fails on the counterparty chain, the refund returns to the caller as
+    /// ERC20 tokens again.
+    ///
+    /// @param sourceChannel Channel of the transfer on Nibiru (e.g. "channel-0")
+    /// @param sourcePort Port of the transfer on Nibiru, usually "transfer"
+    /// @param denomOrErc20 Bank coin denomination, or the hex address of a
+    /// FunToken ERC20
+    /// @param amount Amount of tokens to send
+    /// @param receiver Address of the recipient on the counterparty chain
+    /// @param timeoutHeight Counterparty height after which the transfer
+    /// times out. Zero disables the timeout height.
+    /// @param timeoutTimestamp Unix time in nanoseconds after which the
+    /// transfer times out. Zero disables the timeout timestamp.
+    /// @param memo Optional memo of the transfer
+    /// @return sequence Sequence number of the IBC packet
+    function transfer(
+        string memory sourceChannel,
+        string memory sourcePort,
+        string memory denomOrErc20,
+        uint256 amount,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 sequence);
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+IStaking constant STAKING_PRECOMPILE = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+import "./NibiruEvmUtils.sol";
+
+/// @notice Implements staking from the EVM using the "x/staking" and
+/// "x/distribution" modules. The caller (msg.sender) is the delegator, and all
+/// amounts are in units of the staking bond denomination ("unibi").
+/// Validators are identified by their "nibivaloper"-prefixed Bech32 address.
+interface IStaking is INibiruEvm {
+    /// @notice Delegates tokens of the caller to a validator.
+    /// @param validatorAddr Bech32 operator address of the validator
+    /// @param amount Amount of "unibi" to delegate
+    /// @return success True if the delegation succeeded
+    function delegate(
+        string memory validatorAddr,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @notice Undelegates tokens of the caller from a validator. The tokens
+    /// return to the caller once the unbonding period completes.
+    /// @param validatorAddr Bech32 operator address of the validator
+    /// @param amount Amount of "unibi" to undelegate
+    /// @return completionTime Unix time in seconds when unbonding completes
+    function undelegate(
+        string memory validatorAddr,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Moves a delegation of the caller from one validator to another
+    /// without unbonding.
+    /// @param srcValidatorAddr Bech32 operator address of the source validator
+    /// @param dstValidatorAddr Bech32 operator address of the destination
+    /// validator
+    /// @param amount Amount of "unibi" to redelegate
+    /// @return completionTime Unix time in seconds when the redelegation
+    /// completes
+    function redelegate(
+        string memory srcValidatorAddr,
+        string memory dstValidatorAddr,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Withdraws the staking rewards of the caller from a validator to
+    /// the bank balance of the caller.
+    /// @param validatorAddr Bech32 operator address of the validator
+    /// @return rewards Coins withdrawn as rewards
+    function withdrawDelegatorRewards(
+        string memory validatorAddr
+    ) external returns (INibiruEvm.BankCoin[] memory rewards);
+
+    /// @notice Queries the delegation of an account to a validator. Both
+    /// return values are zero if the delegation does not exist.
+    /// @param delegator Address of the delegator
+    /// @param validatorAddr Bech32 operator address of the validator
+    /// @return shares Delegator shares as a fixed point number with 18 decimals
+    /// @return balance Amount of "unibi" that the shares are worth
+    function delegation(
+        address delegator,
+        string memory validatorAddr
+    ) external view returns (uint256 shares, uint256 balance);
+
+    struct Validator {
+        string operatorAddress;
+        string moniker;
+        bool jailed;
+        /// @dev 1 = unbonded, 2 = unbonding, 3 = bonded
+        uint8 status;
+        uint256 tokens;
+        /// @dev Fixed point number with 18 decimals
+        uint256 delegatorShares;
+        /// @dev Fixed point number with 18 decimals
+        uint256 commissionRate;
+    }
+
+    /// @notice Queries a validator by its operator address.
+    /// @param validatorAddr Bech32 operator address of the validator
+    function validator(
+        string memory validatorAddr
+    ) external view returns (Validator memory);
+}
@@ -25,6 +25,12 @@ var (
 	funtokenPrecompileJSON []byte
 	//go:embed artifacts/contracts/Wasm.sol/IWasm.json
 	wasmPrecompileJSON []byte
+	//go:embed artifacts/contracts/IStaking.sol/IStaking.json
+	stakingPrecompileJSON []byte
+	//go:embed artifacts/contracts/IICS20.sol/IICS20.json
+	ics20PrecompileJSON []byte
+	//go:embed artifacts/contracts/IGovernance.sol/IGovernance.json
+	governancePrecompileJSON []byte
 	//go:embed artifacts/contracts/TestERC20.sol/TestERC20.json
 	testErc20Json []byte
 	//go:embed artifacts/contracts/TestERC20MaliciousName.sol/TestERC20MaliciousName.json
@@ -85,6 +91,27 @@ var (
 		Name:      "Wasm.sol",
 		EmbedJSON: wasmPrecompileJSON,
 	}
+	// SmartContract_Staking: Precompile contract interface for
+	// "IStaking.sol". This precompile enables delegations and staking reward
+	// withdrawals from EVM accounts. Only the ABI is used.
+	SmartContract_Staking = CompiledEvmContract{
+		Name:      "IStaking.sol",
+		EmbedJSON: stakingPrecompileJSON,
+	}
+	// SmartContract_ICS20: Precompile contract interface for "IICS20.sol".
+	// This precompile enables IBC transfers of bank coins and FunToken ERC20s
+	// from EVM accounts. Only the ABI is used.
+	SmartContract_ICS20 = CompiledEvmContract{
+		Name:      "IICS20.sol",
+		EmbedJSON: ics20PrecompileJSON,
+	}
+	// SmartContract_Governance: Precompile contract interface for
+	// "IGovernance.sol". This precompile enables votes, deposits, and proposal
+	// queries from EVM accounts. Only the ABI is used.
+	SmartContract_Governance = CompiledEvmContract{
+		Name:      "IGovernance.sol",
+		EmbedJSON: governancePrecompileJSON,
+	}
 	SmartContract_Oracle = CompiledEvmContract{
 		Name:      "Oracle.sol",
 		EmbedJSON: oracleContractJSON,
@@ -184,6 +211,9 @@ func init() {
 	SmartContract_FunToken.MustLoad()
 	SmartContract_Wasm.MustLoad()
 	SmartContract_Oracle.MustLoad()
+	SmartContract_Staking.MustLoad()
+	SmartContract_ICS20.MustLoad()
+	SmartContract_Governance.MustLoad()
 	SmartContract_TestERC20.MustLoad()
 	SmartContract_TestERC20MaliciousName.MustLoad()
 	SmartContract_TestERC20MaliciousTransfer.MustLoad()
@@ -20,6 +20,7 @@ const (
 	codeErrInvalidBaseFee
 	codeErrInvalidAccount
 	codeErrInactivePrecompile
+	codeErrNestedEvmCall
 )
 
 var (
@@ -49,6 +50,10 @@ var (
 
 	// ErrInvalidAccount returns an error if the account is not an EVM compatible account
 	ErrInvalidAccount = sdkioerrors.Register(ModuleName, codeErrInvalidAccount, "account type is not a valid ethereum account")
+
+	// ErrNestedEvmCall returns an error if a Wasm contract calls the EVM while
+	// an EVM tx is executing, as in EVM -> Wasm precompile -> "evm_call".
+	ErrNestedEvmCall = sdkioerrors.Register(ModuleName, codeErrNestedEvmCall, "evm_call is not allowed while an EVM tx is executing")
 )
 
 // NewRevertError unpacks the revert return bytes and returns a wrapped error
@@ -22,6 +22,12 @@ const (
 	// proto.MessageName(new(evm.TypeUrlEventEthereumTx))
 	TypeUrlEventEthereumTx = "eth.evm.v1.EventEthereumTx"
 
+	// proto.MessageName(new(evm.EventConvertCoinToEvm))
+	TypeUrlEventConvertCoinToEvm = "eth.evm.v1.EventConvertCoinToEvm"
+
+	// proto.MessageName(new(evm.EventConvertEvmToCoin))
+	TypeUrlEventConvertEvmToCoin = "eth.evm.v1.EventConvertEvmToCoin"
+
 	// Untyped events and attribuges
 
 	// Used in non-typed event "message"
@@ -354,6 +354,76 @@ func (m *EventConvertCoinToEvm) GetBankCoin() types.Coin {
 	return types.Coin{}
 }
 
+// EventConvertEvmToCoin defines the event of converting ERC20 tokens of a
+// fun token to their bank coin representation.
+type EventConvertEvmToCoin struct {
+	Sender               string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToBankAddr           string     `protobuf:"bytes,3,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *EventConvertEvmToCoin) Reset()         { *m = EventConvertEvmToCoin{} }
+func (m *EventConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*EventConvertEvmToCoin) ProtoMessage()    {}
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{5}
+}
+func (m *EventConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertEvmToCoin.Merge(m, src)
+}
+func (m *EventConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertEvmToCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertEvmToCoin proto.InternalMessageInfo
+
+func (m *EventConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetToBankAddr() string {
+	if m != nil {
+		return m.ToBankAddr
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetBankCoin() types.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types.Coin{}
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -365,7 +435,7 @@ func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
 func (m *EventTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventTransfer) ProtoMessage()    {}
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{5}
+	return fileDescriptor_f8bc26b53c788f17, []int{6}
 }
 func (m *EventTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +495,7 @@ func (m *EventContractDeployed) Reset()         { *m = EventContractDeployed{} }
 func (m *EventContractDeployed) String() string { return proto.CompactTextString(m) }
 func (*EventContractDeployed) ProtoMessage()    {}
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{6}
+	return fileDescriptor_f8bc26b53c788f17, []int{7}
 }
 func (m *EventContractDeployed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +548,7 @@ func (m *EventContractExecuted) Reset()         { *m = EventContractExecuted{} }
 func (m *EventContractExecuted) String() string { return proto.CompactTextString(m) }
 func (*EventContractExecuted) ProtoMessage()    {}
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{7}
+	return fileDescriptor_f8bc26b53c788f17, []int{8}
 }
 func (m *EventContractExecuted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,6 +597,7 @@ func init() {
 	proto.RegisterType((*EventBlockBloom)(nil), "eth.evm.v1.EventBlockBloom")
 	proto.RegisterType((*EventFunTokenCreated)(nil), "eth.evm.v1.EventFunTokenCreated")
 	proto.RegisterType((*EventConvertCoinToEvm)(nil), "eth.evm.v1.EventConvertCoinToEvm")
+	proto.RegisterType((*EventConvertEvmToCoin)(nil), "eth.evm.v1.EventConvertEvmToCoin")
 	proto.RegisterType((*EventTransfer)(nil), "eth.evm.v1.EventTransfer")
 	proto.RegisterType((*EventContractDeployed)(nil), "eth.evm.v1.EventContractDeployed")
 	proto.RegisterType((*EventContractExecuted)(nil), "eth.evm.v1.EventContractExecuted")
@@ -535,47 +606,49 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/events.proto", fileDescriptor_f8bc26b53c788f17) }
 
 var fileDescriptor_f8bc26b53c788f17 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0xdb, 0xf4, 0x4f, 0xb6, 0xbf, 0xfe, 0x0a, 0x56, 0x28, 0x6e, 0x05, 0x6e, 0x65, 0x24,
-	0x68, 0x2f, 0x36, 0x09, 0x48, 0x48, 0x9c, 0x20, 0x69, 0x2a, 0x0e, 0x05, 0xa1, 0x28, 0x5c, 0x90,
-	0x90, 0xb5, 0xb6, 0xa7, 0xb6, 0xd5, 0xec, 0x4e, 0xb5, 0xbb, 0xb6, 0xd2, 0xb7, 0xe0, 0x51, 0x78,
-	0x06, 0x4e, 0x3d, 0xf6, 0x06, 0xa7, 0x0a, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0x5d, 0xbb, 0x4d, 0x03,
-	0xea, 0x05, 0x6e, 0x33, 0xdf, 0xfc, 0xd9, 0x99, 0x6f, 0x3e, 0x2d, 0xb9, 0x0f, 0x2a, 0x0b, 0xa0,
-	0x64, 0x41, 0xd9, 0x09, 0xa0, 0x04, 0xae, 0xa4, 0x7f, 0x2c, 0x50, 0xa1, 0x4d, 0x40, 0x65, 0x3e,
-	0x94, 0xcc, 0x2f, 0x3b, 0x9b, 0x6e, 0x8c, 0x92, 0xa1, 0x0c, 0x22, 0x2a, 0x21, 0x28, 0x3b, 0x11,
-	0x28, 0xda, 0x09, 0x62, 0xcc, 0x79, 0x95, 0xbb, 0xd9, 0x4e, 0x31, 0x45, 0x63, 0x06, 0xda, 0xba,
-	0x42, 0x67, 0x5a, 0xb3, 0x0a, 0xf5, 0xbe, 0x5a, 0x64, 0x6d, 0xa0, 0x1f, 0x1a, 0xa8, 0x0c, 0x04,
-	0x14, 0x6c, 0x34, 0xb1, 0xd7, 0xc9, 0x22, 0x65, 0x58, 0x70, 0xe5, 0x58, 0xdb, 0xd6, 0x4e, 0x6b,
-	0x58, 0x7b, 0xf6, 0x06, 0x59, 0x06, 0x95, 0x85, 0x19, 0x95, 0x99, 0x33, 0x67, 0x22, 0x4b, 0xa0,
-	0xb2, 0x37, 0x54, 0x66, 0x76, 0x9b, 0x2c, 0xe4, 0x3c, 0x81, 0x89, 0x33, 0x6f, 0xf0, 0xca, 0xd1,
-	0x05, 0x29, 0x95, 0x61, 0x21, 0x21, 0x71, 0x9a, 0x55, 0x41, 0x4a, 0xe5, 0x07, 0x09, 0x89, 0x6d,
-	0x93, 0xa6, 0xe9, 0xb3, 0x60, 0x60, 0x63, 0xdb, 0x0f, 0x48, 0x4b, 0x40, 0x9c, 0x1f, 0xe7, 0xc0,
-	0x95, 0xb3, 0x68, 0x02, 0x53, 0x40, 0x37, 0x2b, 0x59, 0x08, 0x42, 0xa0, 0x70, 0x96, 0xaa, 0x66,
-	0x25, 0x1b, 0x68, 0xd7, 0x7b, 0x41, 0x88, 0xd9, 0x61, 0x34, 0x39, 0xc0, 0xd4, 0xde, 0x25, 0xcd,
-	0x31, 0xa6, 0xd2, 0xb1, 0xb6, 0xe7, 0x77, 0x56, 0xba, 0x6b, 0xfe, 0x94, 0x39, 0xff, 0x00, 0xd3,
-	0x5e, 0xf3, 0xf4, 0x7c, 0xab, 0x31, 0x34, 0x29, 0xde, 0x93, 0x7a, 0xf9, 0xde, 0x18, 0xe3, 0xa3,
-	0xde, 0x18, 0x91, 0xe9, 0x4d, 0x22, 0x6d, 0xd4, 0xbb, 0x57, 0x8e, 0xf7, 0xc5, 0x22, 0x6d, 0x93,
-	0xb9, 0x5f, 0xf0, 0x11, 0x1e, 0x01, 0xef, 0x0b, 0xa0, 0x0a, 0x12, 0xfb, 0x21, 0x21, 0x11, 0xe5,
-	0x47, 0x61, 0x02, 0xfc, 0xba, 0xa6, 0xa5, 0x91, 0x3d, 0x0d, 0xd8, 0xcf, 0xc9, 0x3a, 0x88, 0xb8,
-	0xfb, 0x34, 0x8c, 0x91, 0x2b, 0x41, 0x63, 0x15, 0xd2, 0x24, 0x11, 0x20, 0x65, 0x4d, 0x60, 0xdb,
-	0x44, 0xfb, 0x75, 0xf0, 0x75, 0x15, 0xb3, 0x1d, 0xb2, 0x14, 0xeb, 0xfe, 0x28, 0x6a, 0x3e, 0xaf,
-	0x5c, 0x7b, 0x97, 0xdc, 0xcd, 0x65, 0xc8, 0x68, 0x02, 0xe1, 0xa1, 0x40, 0x16, 0xea, 0xab, 0x1b,
-	0x6a, 0x97, 0x87, 0xff, 0xe7, 0xf2, 0x2d, 0x4d, 0x60, 0x5f, 0x20, 0xeb, 0x63, 0xce, 0xbd, 0x6f,
-	0x16, 0xb9, 0x67, 0x46, 0xee, 0x23, 0x2f, 0x41, 0x28, 0x0d, 0x8e, 0x70, 0x50, 0x32, 0x7d, 0x5f,
-	0x09, 0x3c, 0x01, 0x71, 0x75, 0xdf, 0xca, 0xfb, 0xcb, 0x61, 0x5d, 0xb2, 0xa2, 0x30, 0xd4, 0xc2,
-	0xd0, 0xd9, 0xf5, 0xc0, 0x2d, 0x85, 0x03, 0x95, 0xe9, 0x14, 0xfb, 0x3d, 0x31, 0x7c, 0x4c, 0x47,
-	0x5d, 0xe9, 0x6e, 0xf8, 0x95, 0x82, 0x7d, 0xad, 0x60, 0xbf, 0x56, 0xb0, 0xaf, 0x07, 0xec, 0x39,
-	0xfa, 0x3a, 0x3f, 0xcf, 0xb7, 0xee, 0x9c, 0x50, 0x36, 0x7e, 0xe9, 0x5d, 0x57, 0x7a, 0xc3, 0x65,
-	0x6d, 0x9b, 0xcd, 0x3e, 0x91, 0xd5, 0xea, 0xdc, 0x82, 0x72, 0x79, 0x08, 0xe2, 0xd6, 0x85, 0x66,
-	0x04, 0x35, 0xf7, 0xbb, 0xa0, 0xa6, 0x32, 0x9f, 0xbf, 0x29, 0x73, 0x6f, 0x34, 0xe5, 0xcd, 0x2c,
-	0xba, 0x07, 0xc7, 0x63, 0x3c, 0x81, 0xe4, 0xd6, 0x67, 0x1e, 0x91, 0xd5, 0x19, 0xc6, 0xea, 0xa7,
-	0xfe, 0x8b, 0x6f, 0x30, 0xf5, 0x47, 0xd7, 0xc1, 0x04, 0xe2, 0x42, 0xfd, 0x63, 0xd7, 0xde, 0xab,
-	0xd3, 0x0b, 0xd7, 0x3a, 0xbb, 0x70, 0xad, 0x1f, 0x17, 0xae, 0xf5, 0xf9, 0xd2, 0x6d, 0x9c, 0x5d,
-	0xba, 0x8d, 0xef, 0x97, 0x6e, 0xe3, 0xe3, 0xe3, 0x34, 0x57, 0x59, 0x11, 0xf9, 0x31, 0xb2, 0xe0,
-	0x5d, 0x1e, 0xe5, 0xa2, 0xe8, 0x67, 0x34, 0xe7, 0x01, 0x37, 0x76, 0x50, 0x76, 0x83, 0x89, 0xfe,
-	0x06, 0xa2, 0x45, 0xf3, 0x0f, 0x3c, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x52, 0xaf, 0x8b, 0xfe,
-	0x7a, 0x04, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8f, 0xdb, 0xf4, 0x4f, 0xb6, 0xed, 0xd7, 0xef, 0xb3, 0xf2, 0x15, 0xb7, 0x02, 0xb7, 0x32,
+	0x12, 0xb4, 0x17, 0x9b, 0x04, 0x24, 0x24, 0x4e, 0x90, 0x34, 0x15, 0x87, 0x82, 0x50, 0x14, 0x2e,
+	0x48, 0xc8, 0xda, 0xd8, 0x53, 0xdb, 0x4a, 0x76, 0xa7, 0xda, 0x5d, 0x5b, 0xe9, 0x5b, 0xf0, 0x28,
+	0x3c, 0x03, 0xa7, 0x1e, 0x7b, 0x03, 0x2e, 0x15, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xbb, 0x76, 0x9b,
+	0xb6, 0xa8, 0x17, 0xfe, 0xdc, 0x66, 0x7e, 0x33, 0x3b, 0x3b, 0xbf, 0xdf, 0xce, 0x2c, 0xb9, 0x03,
+	0x2a, 0x0d, 0xa0, 0x60, 0x41, 0xd1, 0x0a, 0xa0, 0x00, 0xae, 0xa4, 0x7f, 0x28, 0x50, 0xa1, 0x4d,
+	0x40, 0xa5, 0x3e, 0x14, 0xcc, 0x2f, 0x5a, 0x1b, 0x6e, 0x84, 0x92, 0xa1, 0x0c, 0x86, 0x54, 0x42,
+	0x50, 0xb4, 0x86, 0xa0, 0x68, 0x2b, 0x88, 0x30, 0xe3, 0x65, 0xee, 0x46, 0x33, 0xc1, 0x04, 0x8d,
+	0x19, 0x68, 0xeb, 0x02, 0xbd, 0x56, 0x9a, 0x95, 0xa8, 0xf7, 0xc9, 0x22, 0xab, 0x3d, 0x7d, 0x51,
+	0x4f, 0xa5, 0x20, 0x20, 0x67, 0x83, 0x89, 0xbd, 0x46, 0xe6, 0x29, 0xc3, 0x9c, 0x2b, 0xc7, 0xda,
+	0xb2, 0xb6, 0x1b, 0xfd, 0xca, 0xb3, 0xd7, 0xc9, 0x22, 0xa8, 0x34, 0x4c, 0xa9, 0x4c, 0x9d, 0x19,
+	0x13, 0x59, 0x00, 0x95, 0xbe, 0xa4, 0x32, 0xb5, 0x9b, 0x64, 0x2e, 0xe3, 0x31, 0x4c, 0x9c, 0x59,
+	0x83, 0x97, 0x8e, 0x3e, 0x90, 0x50, 0x19, 0xe6, 0x12, 0x62, 0xa7, 0x5e, 0x1e, 0x48, 0xa8, 0x7c,
+	0x2b, 0x21, 0xb6, 0x6d, 0x52, 0x37, 0x75, 0xe6, 0x0c, 0x6c, 0x6c, 0xfb, 0x2e, 0x69, 0x08, 0x88,
+	0xb2, 0xc3, 0x0c, 0xb8, 0x72, 0xe6, 0x4d, 0x60, 0x0a, 0xe8, 0x62, 0x05, 0x0b, 0x41, 0x08, 0x14,
+	0xce, 0x42, 0x59, 0xac, 0x60, 0x3d, 0xed, 0x7a, 0x4f, 0x09, 0x31, 0x1c, 0x06, 0x93, 0x7d, 0x4c,
+	0xec, 0x1d, 0x52, 0x1f, 0x63, 0x22, 0x1d, 0x6b, 0x6b, 0x76, 0x7b, 0xa9, 0xbd, 0xea, 0x4f, 0x95,
+	0xf3, 0xf7, 0x31, 0xe9, 0xd4, 0x8f, 0x4f, 0x37, 0x6b, 0x7d, 0x93, 0xe2, 0x3d, 0xac, 0xc8, 0x77,
+	0xc6, 0x18, 0x8d, 0x3a, 0x63, 0x44, 0xa6, 0x99, 0x0c, 0xb5, 0x51, 0x71, 0x2f, 0x1d, 0xef, 0xa3,
+	0x45, 0x9a, 0x26, 0x73, 0x2f, 0xe7, 0x03, 0x1c, 0x01, 0xef, 0x0a, 0xa0, 0x0a, 0x62, 0xfb, 0x1e,
+	0x21, 0x43, 0xca, 0x47, 0x61, 0x0c, 0xfc, 0xf2, 0x4c, 0x43, 0x23, 0xbb, 0x1a, 0xb0, 0x9f, 0x90,
+	0x35, 0x10, 0x51, 0xfb, 0x51, 0x18, 0x21, 0x57, 0x82, 0x46, 0x2a, 0xa4, 0x71, 0x2c, 0x40, 0xca,
+	0x4a, 0xc0, 0xa6, 0x89, 0x76, 0xab, 0xe0, 0x8b, 0x32, 0x66, 0x3b, 0x64, 0x21, 0xd2, 0xf5, 0x51,
+	0x54, 0x7a, 0x5e, 0xb8, 0xf6, 0x0e, 0xf9, 0x2f, 0x93, 0x21, 0xa3, 0x31, 0x84, 0x07, 0x02, 0x59,
+	0xa8, 0x5f, 0xdd, 0x48, 0xbb, 0xd8, 0xff, 0x27, 0x93, 0xaf, 0x68, 0x0c, 0x7b, 0x02, 0x59, 0x17,
+	0x33, 0xee, 0x7d, 0xb6, 0xc8, 0xff, 0xa6, 0xe5, 0x2e, 0xf2, 0x02, 0x84, 0xd2, 0xe0, 0x00, 0x7b,
+	0x05, 0xd3, 0xef, 0x2b, 0x81, 0xc7, 0x20, 0x2e, 0xde, 0xb7, 0xf4, 0x7e, 0xb1, 0x59, 0x97, 0x2c,
+	0x29, 0x0c, 0xf5, 0x60, 0xe8, 0xec, 0xaa, 0xe1, 0x86, 0xc2, 0x9e, 0x4a, 0x75, 0x8a, 0xfd, 0x86,
+	0x18, 0x3d, 0xa6, 0xad, 0x2e, 0xb5, 0xd7, 0xfd, 0x72, 0x82, 0x7d, 0x3d, 0xc1, 0x7e, 0x35, 0xc1,
+	0xbe, 0x6e, 0xb0, 0xe3, 0xe8, 0xd7, 0xf9, 0x7e, 0xba, 0xf9, 0xef, 0x11, 0x65, 0xe3, 0x67, 0xde,
+	0xe5, 0x49, 0xaf, 0xbf, 0xa8, 0x6d, 0xc3, 0xec, 0xeb, 0x0d, 0x66, 0xbd, 0x82, 0x0d, 0x50, 0x47,
+	0xfe, 0x30, 0xb3, 0x2d, 0xb2, 0xac, 0x30, 0x34, 0x2d, 0x5c, 0xa1, 0x46, 0x14, 0x76, 0x28, 0x1f,
+	0xfd, 0x25, 0x6e, 0xef, 0xc9, 0x4a, 0x39, 0xca, 0x82, 0x72, 0x79, 0x00, 0xe2, 0x56, 0x4a, 0xd7,
+	0x96, 0x65, 0xe6, 0xe6, 0xb2, 0x4c, 0x57, 0x78, 0xf6, 0xea, 0x0a, 0x7b, 0x83, 0xa9, 0x72, 0x86,
+	0xea, 0x2e, 0x1c, 0x8e, 0xf1, 0x08, 0xe2, 0x5b, 0xaf, 0xb9, 0x4f, 0x56, 0xae, 0x69, 0x56, 0x5d,
+	0xb5, 0x1c, 0x5d, 0xd1, 0xea, 0xa7, 0xaa, 0xbd, 0x09, 0x44, 0xb9, 0xfa, 0xcd, 0xaa, 0x9d, 0xe7,
+	0xc7, 0x67, 0xae, 0x75, 0x72, 0xe6, 0x5a, 0xdf, 0xce, 0x5c, 0xeb, 0xc3, 0xb9, 0x5b, 0x3b, 0x39,
+	0x77, 0x6b, 0x5f, 0xce, 0xdd, 0xda, 0xbb, 0x07, 0x49, 0xa6, 0xd2, 0x7c, 0xe8, 0x47, 0xc8, 0x82,
+	0xd7, 0xd9, 0x30, 0x13, 0x79, 0x37, 0xa5, 0x19, 0x0f, 0xb8, 0xb1, 0x83, 0xa2, 0x1d, 0x4c, 0xf4,
+	0x17, 0x37, 0x9c, 0x37, 0x7f, 0xdc, 0xe3, 0x1f, 0x03, 0x00, 0xe7, 0x2d, 0xf9, 0xa5, 0x56, 0x05,
+	0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -825,6 +898,60 @@ func (m *EventConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToBankAddr) > 0 {
+		i -= len(m.ToBankAddr)
+		copy(dAtA[i:], m.ToBankAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToBankAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1066,6 +1193,29 @@ func (m *EventConvertCoinToEvm) Size() (n int) {
 	return n
 }
 
+func (m *EventConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToBankAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EventTransfer) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1912,6 +2062,185 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBankAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
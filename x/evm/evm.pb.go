@@ -96,6 +96,27 @@ type Params struct {
 	// Fee deducted and burned when calling "CreateFunToken" in units of
 	// "evm_denom".
 	CreateFuntokenFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=create_funtoken_fee,json=createFuntokenFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_funtoken_fee"`
+	// base_fee_target_gas is the amount of EVM gas used in a block at which the
+	// EIP-1559 base fee stays constant. The base fee rises when a block uses more
+	// gas than the target and falls when it uses less. A value of zero disables
+	// the adjustment, keeping the base fee fixed.
+	BaseFeeTargetGas uint64 `protobuf:"varint,10,opt,name=base_fee_target_gas,json=baseFeeTargetGas,proto3" json:"base_fee_target_gas,omitempty"`
+	// base_fee_change_denominator bounds the relative change of the base fee
+	// from one block to the next to 1 / base_fee_change_denominator. Must be
+	// positive when base_fee_target_gas is set.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,11,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// min_base_fee is the lower bound (floor) of the base fee in units of
+	// micronibi (unibi) per gas.
+	MinBaseFee cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_base_fee"`
+	// max_base_fee is the upper bound (ceiling) of the base fee in units of
+	// micronibi (unibi) per gas. A value of zero means there is no upper bound.
+	MaxBaseFee cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_base_fee"`
+	// enable_cancun activates the Cancun hard fork of the EVM: transient
+	// storage (EIP-1153), MCOPY (EIP-5656), SELFDESTRUCT only in the same
+	// transaction (EIP-6780) and the BLOBHASH and BLOBBASEFEE opcodes. Blob
+	// transactions (EIP-4844) remain unsupported, so BLOBBASEFEE always returns
+	// zero.
+	EnableCancun bool `protobuf:"varint,14,opt,name=enable_cancun,json=enableCancun,proto3" json:"enable_cancun,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -145,6 +166,27 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetBaseFeeTargetGas() uint64 {
+	if m != nil {
+		return m.BaseFeeTargetGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetEnableCancun() bool {
+	if m != nil {
+		return m.EnableCancun
+	}
+	return false
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -553,67 +595,73 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 954 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x1b, 0x27, 0x71, 0x26, 0xe9, 0xd6, 0x9d, 0x16, 0x64, 0x21, 0x6d, 0x1c, 0xf9, 0x80,
-	0x82, 0xb4, 0x4a, 0xd8, 0xae, 0xca, 0xa1, 0x5c, 0x68, 0xb2, 0xad, 0x68, 0xa0, 0x4b, 0x35, 0x5b,
-	0x38, 0x70, 0xb1, 0x26, 0xf6, 0x6b, 0x62, 0xc5, 0xf6, 0x44, 0x33, 0xe3, 0x28, 0xf9, 0x07, 0x1c,
-	0xf9, 0x09, 0x7b, 0xe7, 0x8f, 0xac, 0x38, 0xed, 0x11, 0x71, 0xb0, 0x50, 0x7b, 0x41, 0x39, 0x72,
-	0x42, 0x9c, 0xd0, 0x8c, 0xdd, 0x4d, 0x0a, 0x12, 0x9c, 0xfc, 0xbe, 0xef, 0xcd, 0x7b, 0xf3, 0xe6,
-	0xfb, 0xc6, 0x36, 0x3a, 0x02, 0x39, 0xed, 0xc3, 0x22, 0xe9, 0x2f, 0x9e, 0xab, 0x47, 0x6f, 0xce,
-	0x99, 0x64, 0x18, 0x81, 0x9c, 0xf6, 0x14, 0x5c, 0x3c, 0xff, 0xe8, 0x68, 0xc2, 0x26, 0x4c, 0xd3,
-	0x7d, 0x15, 0x15, 0x2b, 0xbc, 0x9f, 0x0c, 0x64, 0x5d, 0x64, 0xe9, 0x0d, 0x9b, 0x41, 0x8a, 0xbf,
-	0x45, 0x08, 0x78, 0x70, 0xfc, 0xa9, 0x4f, 0xc3, 0x90, 0x3b, 0x46, 0xc7, 0xe8, 0x36, 0x06, 0x9f,
-	0xbd, 0xcd, 0xdd, 0x9d, 0x5f, 0x73, 0xb7, 0x37, 0x89, 0xe4, 0x34, 0x1b, 0xf7, 0x02, 0x96, 0xf4,
-	0x5f, 0x45, 0xe3, 0x88, 0x67, 0xc3, 0x29, 0x8d, 0xd2, 0x7e, 0xaa, 0xe3, 0xfe, 0xe2, 0xb8, 0xaf,
-	0xf6, 0x3a, 0xbf, 0xbc, 0x3e, 0x39, 0x39, 0x0b, 0x43, 0x4e, 0x1a, 0xba, 0x93, 0x0a, 0xf1, 0x53,
-	0x84, 0xc6, 0x34, 0x9d, 0xf9, 0x21, 0xa4, 0x2c, 0x71, 0x76, 0x55, 0x5b, 0xd2, 0x50, 0xcc, 0x4b,
-	0x45, 0xe0, 0x4f, 0xd0, 0x41, 0x24, 0xfc, 0x84, 0x86, 0xe0, 0xdf, 0x72, 0x96, 0xf8, 0x01, 0x8b,
-	0x52, 0xa7, 0xd2, 0x31, 0xba, 0x16, 0x79, 0x12, 0x89, 0x2b, 0x1a, 0xc2, 0x05, 0x67, 0xc9, 0x90,
-	0x45, 0xa9, 0xf7, 0xa7, 0x81, 0x6a, 0xd7, 0x94, 0xd3, 0x44, 0xe0, 0x33, 0x84, 0x60, 0x29, 0x39,
-	0xf5, 0x21, 0x9a, 0x0b, 0xc7, 0xec, 0x54, 0xba, 0x95, 0x81, 0x77, 0x97, 0xbb, 0x8d, 0x73, 0xc5,
-	0x9e, 0x5f, 0x5e, 0x8b, 0x3f, 0x72, 0xf7, 0x60, 0x45, 0x93, 0xf8, 0xd4, 0xdb, 0x2c, 0xf4, 0x48,
-	0x43, 0x83, 0xf3, 0x68, 0x2e, 0xf0, 0x31, 0x6a, 0xc1, 0x22, 0xf1, 0x83, 0x29, 0x4d, 0x53, 0x88,
-	0x85, 0x63, 0x75, 0x2a, 0xdd, 0xc6, 0x60, 0xff, 0x2e, 0x77, 0x9b, 0xe7, 0xdf, 0x5d, 0x0d, 0x4b,
-	0x9a, 0x34, 0x61, 0x91, 0x3c, 0x00, 0x7c, 0x85, 0x0e, 0x03, 0x0e, 0x54, 0x82, 0x7f, 0x9b, 0xa5,
-	0x52, 0xa9, 0xe6, 0xdf, 0x02, 0x38, 0x0d, 0xad, 0xd5, 0xd3, 0x52, 0xab, 0x0f, 0x02, 0x26, 0x12,
-	0x26, 0x44, 0x38, 0xeb, 0x45, 0xac, 0x9f, 0x50, 0x39, 0xed, 0x5d, 0xa6, 0x92, 0x1c, 0x14, 0x95,
-	0x17, 0x65, 0xe1, 0x05, 0xc0, 0xa9, 0xf9, 0xfb, 0x1b, 0xd7, 0x18, 0x99, 0x96, 0x61, 0xef, 0x8e,
-	0x4c, 0x6b, 0xd7, 0xae, 0x8c, 0x4c, 0xab, 0x62, 0x9b, 0x23, 0xd3, 0xaa, 0xda, 0xb5, 0x91, 0x69,
-	0xd5, 0xec, 0xfa, 0xc8, 0xb4, 0xea, 0xb6, 0xe5, 0xf5, 0x51, 0xf5, 0xb5, 0xa4, 0x12, 0xb0, 0x8d,
-	0x2a, 0x33, 0x58, 0x15, 0xee, 0x10, 0x15, 0xe2, 0x23, 0x54, 0x5d, 0xd0, 0x38, 0x83, 0x52, 0xda,
-	0x02, 0x78, 0x3f, 0xef, 0xa2, 0xca, 0xd7, 0x6c, 0x82, 0x1d, 0x54, 0x57, 0x76, 0x82, 0x10, 0x65,
-	0xcd, 0x03, 0xc4, 0x1f, 0xa2, 0x9a, 0x64, 0xf3, 0x28, 0x10, 0xce, 0xae, 0x3a, 0x39, 0x29, 0x11,
-	0xc6, 0xc8, 0x0c, 0xa9, 0xa4, 0xda, 0x83, 0x16, 0xd1, 0xb1, 0xd2, 0x6a, 0x1c, 0xb3, 0x60, 0xe6,
-	0xa7, 0x59, 0x32, 0x06, 0xee, 0x98, 0x1d, 0xa3, 0x6b, 0x0e, 0xf6, 0xd7, 0xb9, 0xdb, 0xd4, 0xfc,
-	0x2b, 0x4d, 0x93, 0x6d, 0x80, 0x9f, 0xa1, 0xba, 0x5c, 0xfa, 0x53, 0x2a, 0xa6, 0x4e, 0x55, 0xeb,
-	0x73, 0xb8, 0xce, 0xdd, 0x7d, 0xc9, 0x69, 0x2a, 0x68, 0x20, 0x23, 0x96, 0x7e, 0x49, 0xc5, 0x94,
-	0xd4, 0xe4, 0x52, 0x3d, 0x71, 0x1f, 0x59, 0x72, 0xe9, 0x47, 0x69, 0x08, 0x4b, 0xa7, 0xa6, 0xbb,
-	0x1f, 0xad, 0x73, 0xd7, 0xde, 0x5a, 0x7e, 0xa9, 0x72, 0xa4, 0x2e, 0x97, 0x3a, 0xc0, 0xcf, 0x10,
-	0x2a, 0x46, 0xd2, 0x3b, 0xd4, 0xf5, 0x0e, 0x7b, 0xeb, 0xdc, 0x6d, 0x68, 0x56, 0xf7, 0xde, 0x84,
-	0xd8, 0x43, 0xd5, 0xa2, 0xb7, 0xa5, 0x7b, 0xb7, 0xd6, 0xb9, 0x6b, 0xc5, 0x6c, 0x52, 0xf4, 0x2c,
-	0x52, 0x4a, 0x2a, 0x0e, 0x09, 0x5b, 0x40, 0xa8, 0x0d, 0xb5, 0xc8, 0x03, 0xf4, 0x28, 0x6a, 0x9e,
-	0x05, 0x01, 0x08, 0x71, 0x93, 0xcd, 0x63, 0xf8, 0x0f, 0x4d, 0x8f, 0x51, 0x4b, 0x48, 0xc6, 0xe9,
-	0x04, 0xfc, 0x19, 0xac, 0x4a, 0x65, 0x0b, 0x9d, 0x4a, 0xfe, 0x2b, 0x58, 0x09, 0xb2, 0x0d, 0x4e,
-	0xcd, 0x1f, 0xde, 0xb8, 0x3b, 0xde, 0x10, 0xb5, 0x6e, 0x38, 0x0d, 0x80, 0x0f, 0x59, 0x7a, 0x1b,
-	0x4d, 0xf0, 0x0b, 0xb4, 0xc7, 0xd2, 0x78, 0xe5, 0x4b, 0x36, 0xf7, 0x03, 0x1a, 0xc7, 0x7a, 0x27,
-	0xab, 0x68, 0xa5, 0x12, 0x37, 0x6c, 0x3e, 0xa4, 0x71, 0x4c, 0xb6, 0x81, 0xf7, 0x57, 0x05, 0x35,
-	0x75, 0x97, 0xb2, 0x89, 0xb2, 0x58, 0x37, 0x2d, 0xe7, 0x2c, 0x91, 0x3a, 0x80, 0x8c, 0x12, 0x60,
-	0x99, 0x2c, 0x2f, 0xcd, 0x03, 0x54, 0x15, 0x1c, 0x60, 0x09, 0x81, 0xb6, 0xdf, 0x24, 0x25, 0xc2,
-	0x27, 0x68, 0x2f, 0x8c, 0x04, 0x1d, 0xc7, 0xe0, 0x0b, 0x49, 0x83, 0x99, 0xb6, 0xd4, 0x1a, 0xd8,
-	0xeb, 0xdc, 0x6d, 0x95, 0x89, 0xd7, 0x8a, 0x27, 0x8f, 0x10, 0xfe, 0x1c, 0xed, 0x6f, 0xca, 0xf4,
-	0x91, 0xb5, 0xb9, 0xd6, 0x00, 0xaf, 0x73, 0xf7, 0xc9, 0xfb, 0xa5, 0x3a, 0x43, 0xfe, 0x81, 0xd5,
-	0xc5, 0x0e, 0x61, 0x9c, 0x4d, 0xb4, 0x67, 0x16, 0x29, 0x80, 0x62, 0xe3, 0x28, 0x89, 0xa4, 0xf6,
-	0xa8, 0x4a, 0x0a, 0xa0, 0xe6, 0x83, 0x54, 0xef, 0x93, 0x40, 0xc2, 0xf8, 0xca, 0x69, 0x6e, 0xe6,
-	0x2b, 0x12, 0x57, 0x9a, 0x27, 0x8f, 0x10, 0x1e, 0x20, 0x5c, 0x96, 0x71, 0x90, 0x19, 0x4f, 0x7d,
-	0x7d, 0xf3, 0x5b, 0xba, 0x56, 0xdf, 0xbf, 0x22, 0x4b, 0x74, 0xf2, 0x25, 0x95, 0x94, 0xfc, 0x8b,
-	0xc1, 0xdf, 0xa0, 0xbd, 0x42, 0x56, 0x3f, 0xd0, 0xaa, 0x3b, 0x7b, 0x1d, 0xa3, 0xdb, 0x3c, 0x76,
-	0x7a, 0x9b, 0xaf, 0x6f, 0x6f, 0xdb, 0xda, 0x62, 0x28, 0xb9, 0xc5, 0x90, 0x47, 0x68, 0x64, 0x5a,
-	0xa6, 0x5d, 0x2d, 0xde, 0xfb, 0x91, 0x69, 0x21, 0xbb, 0xf9, 0x5e, 0x99, 0xf2, 0x70, 0xe4, 0xf0,
-	0x01, 0x6f, 0x4d, 0x3d, 0xf8, 0xe2, 0xed, 0x5d, 0xdb, 0x78, 0x77, 0xd7, 0x36, 0x7e, 0xbb, 0x6b,
-	0x1b, 0x3f, 0xde, 0xb7, 0x77, 0xde, 0xdd, 0xb7, 0x77, 0x7e, 0xb9, 0x6f, 0xef, 0x7c, 0xff, 0xf1,
-	0xff, 0x7e, 0xbc, 0x97, 0xea, 0xaf, 0x31, 0xae, 0xe9, 0x9f, 0xc2, 0x8b, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x26, 0x36, 0x60, 0x4e, 0x06, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x6e, 0x1a, 0x27, 0x71, 0x26, 0xc9, 0xd6, 0x3b, 0x2d, 0xc8, 0x02, 0x6d, 0x5c, 0x05, 0x09,
+	0x15, 0x69, 0x49, 0xd8, 0xae, 0x96, 0x43, 0x11, 0x82, 0x26, 0x6d, 0xa1, 0x81, 0x2e, 0xd5, 0x6c,
+	0xe1, 0xc0, 0xc5, 0x9a, 0xd8, 0xaf, 0x89, 0x15, 0x7b, 0x26, 0x9a, 0x99, 0x44, 0xc9, 0x3f, 0xe0,
+	0x82, 0xc4, 0x4f, 0xd8, 0x3b, 0x7f, 0x64, 0xc5, 0x69, 0x8f, 0x88, 0x83, 0x85, 0xda, 0x0b, 0xca,
+	0x91, 0x23, 0x27, 0x34, 0x63, 0xa7, 0x49, 0x41, 0x82, 0x3d, 0xe5, 0x7d, 0xdf, 0x9b, 0xf7, 0xf9,
+	0xf9, 0x7b, 0xcf, 0x19, 0xb4, 0x07, 0x6a, 0xd4, 0x81, 0x59, 0xd2, 0x99, 0x3d, 0xd1, 0x3f, 0xed,
+	0x89, 0xe0, 0x8a, 0x63, 0x04, 0x6a, 0xd4, 0xd6, 0x70, 0xf6, 0xe4, 0x9d, 0xbd, 0x21, 0x1f, 0x72,
+	0x43, 0x77, 0x74, 0x94, 0x9d, 0x68, 0xfd, 0x5c, 0x40, 0xf6, 0xd9, 0x94, 0x5d, 0xf1, 0x31, 0x30,
+	0xfc, 0x2d, 0x42, 0x20, 0x82, 0xc3, 0x8f, 0x7c, 0x1a, 0x86, 0xc2, 0x2d, 0xec, 0x17, 0x0e, 0xaa,
+	0xdd, 0x8f, 0x5f, 0xa5, 0xde, 0xd6, 0x6f, 0xa9, 0xd7, 0x1e, 0x46, 0x6a, 0x34, 0x1d, 0xb4, 0x03,
+	0x9e, 0x74, 0x9e, 0x47, 0x83, 0x48, 0x4c, 0x7b, 0x23, 0x1a, 0xb1, 0x0e, 0x33, 0x71, 0x67, 0x76,
+	0xd8, 0xd1, 0xcf, 0x3a, 0x3d, 0xbf, 0x7c, 0xf6, 0xec, 0x38, 0x0c, 0x05, 0xa9, 0x1a, 0x25, 0x1d,
+	0xe2, 0x47, 0x08, 0x0d, 0x28, 0x1b, 0xfb, 0x21, 0x30, 0x9e, 0xb8, 0xdb, 0x5a, 0x96, 0x54, 0x35,
+	0x73, 0xa2, 0x09, 0xfc, 0x01, 0x7a, 0x18, 0x49, 0x3f, 0xa1, 0x21, 0xf8, 0xd7, 0x82, 0x27, 0x7e,
+	0xc0, 0x23, 0xe6, 0x16, 0xf7, 0x0b, 0x07, 0x36, 0x79, 0x10, 0xc9, 0x0b, 0x1a, 0xc2, 0x99, 0xe0,
+	0x49, 0x8f, 0x47, 0xac, 0xf5, 0xa3, 0x85, 0xca, 0x97, 0x54, 0xd0, 0x44, 0xe2, 0x63, 0x84, 0x60,
+	0xae, 0x04, 0xf5, 0x21, 0x9a, 0x48, 0xd7, 0xda, 0x2f, 0x1e, 0x14, 0xbb, 0xad, 0x9b, 0xd4, 0xab,
+	0x9e, 0x6a, 0xf6, 0xf4, 0xfc, 0x52, 0xfe, 0x99, 0x7a, 0x0f, 0x17, 0x34, 0x89, 0x8f, 0x5a, 0xeb,
+	0x83, 0x2d, 0x52, 0x35, 0xe0, 0x34, 0x9a, 0x48, 0x7c, 0x88, 0xea, 0x30, 0x4b, 0xfc, 0x60, 0x44,
+	0x19, 0x83, 0x58, 0xba, 0xf6, 0x7e, 0xf1, 0xa0, 0xda, 0xdd, 0xb9, 0x49, 0xbd, 0xda, 0xe9, 0x77,
+	0x17, 0xbd, 0x9c, 0x26, 0x35, 0x98, 0x25, 0x2b, 0x80, 0x2f, 0xd0, 0x6e, 0x20, 0x80, 0x2a, 0xf0,
+	0xaf, 0xa7, 0x4c, 0x69, 0xd7, 0xfc, 0x6b, 0x00, 0xb7, 0x6a, 0xbc, 0x7a, 0x94, 0x7b, 0xf5, 0x56,
+	0xc0, 0x65, 0xc2, 0xa5, 0x0c, 0xc7, 0xed, 0x88, 0x77, 0x12, 0xaa, 0x46, 0xed, 0x73, 0xa6, 0xc8,
+	0xc3, 0xac, 0xf2, 0x2c, 0x2f, 0x3c, 0x03, 0xc0, 0x1f, 0xa2, 0xdd, 0x01, 0x95, 0xa0, 0x35, 0x7c,
+	0x45, 0xc5, 0x10, 0x94, 0x3f, 0xa4, 0xd2, 0x45, 0xfb, 0x85, 0x03, 0x8b, 0x38, 0x3a, 0x75, 0x06,
+	0x70, 0x65, 0x12, 0x5f, 0x50, 0x89, 0x3f, 0x45, 0xef, 0xde, 0x1d, 0xd7, 0x6d, 0x0f, 0x21, 0x33,
+	0x35, 0x62, 0x54, 0x71, 0xe1, 0xd6, 0x4c, 0x99, 0x9b, 0x97, 0xf5, 0xcc, 0x81, 0x93, 0x75, 0x1e,
+	0x7f, 0x86, 0xea, 0x49, 0xc4, 0xfc, 0x95, 0x84, 0x5b, 0x7f, 0x93, 0xae, 0x51, 0x12, 0xb1, 0x6e,
+	0xa6, 0x68, 0x04, 0xe8, 0x7c, 0x2d, 0xd0, 0x78, 0x33, 0x01, 0x3a, 0x5f, 0x09, 0xbc, 0x87, 0x1a,
+	0xc0, 0xe8, 0x20, 0x06, 0x3f, 0xa0, 0x2c, 0x98, 0x32, 0xf7, 0x81, 0x99, 0x73, 0x3d, 0x23, 0x7b,
+	0x86, 0x3b, 0xb2, 0xfe, 0x78, 0xe9, 0x15, 0xfa, 0x96, 0x5d, 0x70, 0xb6, 0xfb, 0x96, 0xbd, 0xed,
+	0x14, 0xfb, 0x96, 0x5d, 0x74, 0xac, 0xbe, 0x65, 0x97, 0x9c, 0x72, 0xdf, 0xb2, 0xcb, 0x4e, 0xa5,
+	0x6f, 0xd9, 0x15, 0xc7, 0x6e, 0x75, 0x50, 0xe9, 0x85, 0xa2, 0x0a, 0xb0, 0x83, 0x8a, 0x63, 0x58,
+	0x64, 0x2b, 0x4b, 0x74, 0x88, 0xf7, 0x50, 0x69, 0x46, 0xe3, 0x29, 0xe4, 0xfb, 0x96, 0x81, 0xd6,
+	0x2f, 0xdb, 0xa8, 0xf8, 0x35, 0x1f, 0x62, 0x17, 0x55, 0xf4, 0x8e, 0x83, 0x94, 0x79, 0xcd, 0x0a,
+	0xe2, 0xb7, 0x51, 0x59, 0xf1, 0x49, 0x14, 0x48, 0x77, 0x5b, 0xaf, 0x03, 0xc9, 0x11, 0xc6, 0xc8,
+	0x0a, 0xa9, 0xa2, 0x66, 0x31, 0xeb, 0xc4, 0xc4, 0x7a, 0x81, 0x06, 0x31, 0x0f, 0xc6, 0x3e, 0x9b,
+	0x26, 0x03, 0x10, 0xae, 0xa5, 0xfd, 0xef, 0xee, 0x2c, 0x53, 0xaf, 0x66, 0xf8, 0xe7, 0x86, 0x26,
+	0x9b, 0x00, 0x3f, 0x46, 0x15, 0x35, 0xf7, 0x47, 0x54, 0x8e, 0xdc, 0x92, 0x71, 0x6f, 0x77, 0x99,
+	0x7a, 0x3b, 0x4a, 0x50, 0x26, 0x69, 0xa0, 0x22, 0xce, 0xbe, 0xa4, 0x72, 0x44, 0xca, 0x6a, 0xae,
+	0x7f, 0x71, 0x07, 0xd9, 0x6a, 0xee, 0x47, 0x2c, 0x84, 0xb9, 0x5b, 0x36, 0xea, 0x7b, 0xcb, 0xd4,
+	0x73, 0x36, 0x8e, 0x9f, 0xeb, 0x1c, 0xa9, 0xa8, 0xb9, 0x09, 0xf0, 0x63, 0x84, 0xb2, 0x96, 0xcc,
+	0x13, 0x2a, 0xe6, 0x09, 0x8d, 0x65, 0xea, 0x55, 0x0d, 0x6b, 0xb4, 0xd7, 0x21, 0x6e, 0xa1, 0x52,
+	0xa6, 0x6d, 0x1b, 0xed, 0xfa, 0x32, 0xf5, 0xec, 0x98, 0x0f, 0x33, 0xcd, 0x2c, 0xa5, 0xad, 0x12,
+	0x90, 0xf0, 0x19, 0x84, 0x66, 0xcb, 0x6d, 0xb2, 0x82, 0x2d, 0x8a, 0x6a, 0xc7, 0x41, 0x00, 0x52,
+	0x5e, 0x4d, 0x27, 0x31, 0xfc, 0x87, 0xa7, 0x87, 0xa8, 0x2e, 0x15, 0x17, 0x74, 0x08, 0xfe, 0x18,
+	0x16, 0xb9, 0xb3, 0x99, 0x4f, 0x39, 0xff, 0x15, 0x2c, 0x24, 0xd9, 0x04, 0x47, 0xd6, 0x0f, 0x2f,
+	0xbd, 0xad, 0x56, 0x0f, 0xd5, 0xaf, 0x04, 0x0d, 0x40, 0xf4, 0x38, 0xbb, 0x8e, 0x86, 0xf8, 0x29,
+	0x6a, 0x70, 0x16, 0x2f, 0x7c, 0xc5, 0x27, 0x7e, 0x40, 0xe3, 0xd8, 0x3c, 0xc9, 0xce, 0xa4, 0x74,
+	0xe2, 0x8a, 0x4f, 0x7a, 0x34, 0x8e, 0xc9, 0x26, 0x68, 0xfd, 0x55, 0x44, 0x35, 0xa3, 0x92, 0x8b,
+	0xe8, 0x11, 0x1b, 0xd1, 0xbc, 0xcf, 0x1c, 0xe9, 0x17, 0x50, 0x51, 0x02, 0x7c, 0xaa, 0xf2, 0xa5,
+	0x59, 0x41, 0x5d, 0x21, 0x00, 0xe6, 0x10, 0x98, 0xf1, 0x5b, 0x24, 0x47, 0xf8, 0x19, 0x6a, 0x84,
+	0x91, 0x34, 0xfb, 0x2c, 0x15, 0x0d, 0xc6, 0x66, 0xa4, 0x76, 0xd7, 0x59, 0xa6, 0x5e, 0x3d, 0x4f,
+	0xbc, 0xd0, 0x3c, 0xb9, 0x87, 0xf0, 0x27, 0x68, 0x67, 0x5d, 0x66, 0x5e, 0xd9, 0x0c, 0xd7, 0xee,
+	0xe2, 0x65, 0xea, 0x3d, 0xb8, 0x3b, 0x6a, 0x32, 0xe4, 0x1f, 0x58, 0x2f, 0x76, 0x08, 0x83, 0xe9,
+	0xd0, 0xcc, 0xcc, 0x26, 0x19, 0xd0, 0x6c, 0x1c, 0x25, 0x91, 0x32, 0x33, 0x2a, 0x91, 0x0c, 0xe8,
+	0xfe, 0xf2, 0xcf, 0x2d, 0x81, 0x84, 0x8b, 0x85, 0x5b, 0x5b, 0xf7, 0x97, 0x25, 0x2e, 0x0c, 0x4f,
+	0xee, 0x21, 0xdc, 0x45, 0x38, 0x2f, 0x13, 0xa0, 0xa6, 0x82, 0xf9, 0x66, 0xf3, 0xeb, 0xa6, 0xd6,
+	0xec, 0x5f, 0x96, 0x25, 0x26, 0x79, 0x42, 0x15, 0x25, 0xff, 0x62, 0xf0, 0x37, 0xa8, 0x91, 0xd9,
+	0xea, 0x07, 0xc6, 0x75, 0xf3, 0x5f, 0x51, 0x3b, 0x74, 0xdb, 0xeb, 0x2b, 0xa9, 0xbd, 0x39, 0xda,
+	0xac, 0x29, 0xb5, 0xc1, 0x90, 0x7b, 0xa8, 0x6f, 0xd9, 0x96, 0x53, 0xca, 0xbe, 0xfb, 0xbe, 0x65,
+	0x23, 0xa7, 0x76, 0xe7, 0x4c, 0xfe, 0x72, 0x64, 0x77, 0x85, 0x37, 0xba, 0xee, 0x7e, 0xfe, 0xea,
+	0xa6, 0x59, 0x78, 0x7d, 0xd3, 0x2c, 0xfc, 0x7e, 0xd3, 0x2c, 0xfc, 0x74, 0xdb, 0xdc, 0x7a, 0x7d,
+	0xdb, 0xdc, 0xfa, 0xf5, 0xb6, 0xb9, 0xf5, 0xfd, 0xfb, 0xff, 0x7b, 0xa3, 0xcd, 0xf5, 0x55, 0x3a,
+	0x28, 0x9b, 0x9b, 0xf2, 0xe9, 0xdf, 0x03, 0x00, 0x09, 0xae, 0xf4, 0x46, 0x63, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -654,6 +702,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CreateFuntokenFee.Equal(that1.CreateFuntokenFee) {
 		return false
 	}
+	if this.BaseFeeTargetGas != that1.BaseFeeTargetGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if !this.MaxBaseFee.Equal(that1.MaxBaseFee) {
+		return false
+	}
+	if this.EnableCancun != that1.EnableCancun {
+		return false
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -726,6 +789,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableCancun {
+		i--
+		if m.EnableCancun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BaseFeeTargetGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseFeeTargetGas))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.CreateFuntokenFee.Size()
 		i -= size
@@ -1120,6 +1223,19 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.CreateFuntokenFee.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if m.BaseFeeTargetGas != 0 {
+		n += 1 + sovEvm(uint64(m.BaseFeeTargetGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovEvm(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.EnableCancun {
+		n += 2
+	}
 	return n
 }
 
@@ -1569,6 +1685,132 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetGas", wireType)
+			}
+			m.BaseFeeTargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeTargetGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCancun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCancun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,9 @@ func InitGenesis(
 	if err != nil {
 		panic(fmt.Errorf("failed to set params: %w", err))
 	}
+	k.EvmState.BaseFee.Set(
+		ctx, genState.Params.ClampBaseFee(sdkmath.NewIntFromBigInt(evm.BASE_FEE_WEI)),
+	)
 
 	// Note that "GetModuleAccount" initializes the module account with permissions
 	// under the hood if it did not already exist. This is important because the
@@ -0,0 +1,63 @@
+package evmmodule
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ porttypes.IBCModule = (*IBCTransferMiddleware)(nil)
+
+// IBCTransferMiddleware wraps the ICS-20 transfer module so that refunds of
+// transfers sent by the ICS20 precompile from ERC20 tokens return to the EVM
+// sender as ERC20 tokens. It must sit directly above the transfer module in
+// the IBC stack, so that it receives the ICS-20 acknowledgements unwrapped.
+type IBCTransferMiddleware struct {
+	porttypes.IBCModule
+	evmKeeper *keeper.Keeper
+}
+
+func NewIBCTransferMiddleware(
+	app porttypes.IBCModule, evmKeeper *keeper.Keeper,
+) IBCTransferMiddleware {
+	return IBCTransferMiddleware{IBCModule: app, evmKeeper: evmKeeper}
+}
+
+// OnAcknowledgementPacket implements [porttypes.IBCModule]. The transfer
+// module refunds the sender if the acknowledgement is an error.
+func (im IBCTransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		// Unreachable in practice: the transfer module has already rejected
+		// acknowledgements that fail to unmarshal.
+		return nil
+	}
+	im.evmKeeper.OnIBCTransferPacketDone(ctx, packet, !ack.Success())
+	return nil
+}
+
+// OnTimeoutPacket implements [porttypes.IBCModule]. The transfer module
+// refunds the sender of a packet that timed out.
+func (im IBCTransferMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	im.evmKeeper.OnIBCTransferPacketDone(ctx, packet, true)
+	return nil
+}
@@ -43,7 +43,7 @@ import (
 )
 
 // consensusVersion: EVM module consensus version for upgrades.
-const consensusVersion = 1
+const consensusVersion = 3
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -152,6 +152,14 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	evm.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	evm.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(evm.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register migration of %s from version 1 to 2: %s", evm.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(evm.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to register migration of %s from version 2 to 3: %s", evm.ModuleName, err))
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
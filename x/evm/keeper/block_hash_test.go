@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"github.com/NibiruChain/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+// fakeBlockHash returns a deterministic hash for the block at the given height.
+func fakeBlockHash(height int64) gethcommon.Hash {
+	return crypto.Keccak256Hash(sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// beginBlock runs the BeginBlock hook of the EVM module for a block at the
+// given height whose parent has the hash [fakeBlockHash] of "height - 1".
+func beginBlock(deps *evmtest.TestDeps, height int64) {
+	deps.Ctx = deps.Ctx.WithBlockHeader(cmtproto.Header{
+		ChainID: deps.Ctx.ChainID(),
+		Height:  height,
+		Time:    deps.Ctx.BlockTime(),
+		LastBlockId: cmtproto.BlockID{
+			Hash: fakeBlockHash(height - 1).Bytes(),
+		},
+	})
+	deps.EvmKeeper.BeginBlock(deps.Ctx, abci.RequestBeginBlock{})
+}
+
+func (s *Suite) TestBlockHashHistory() {
+	deps := evmtest.NewTestDeps()
+	for height := int64(2); height <= 10; height++ {
+		beginBlock(&deps, height)
+	}
+
+	s.Run("GetHashFn serves parent blocks from the ring buffer", func() {
+		getHash := deps.EvmKeeper.GetHashFn(deps.Ctx)
+		for height := uint64(1); height < 10; height++ {
+			s.Equal(fakeBlockHash(int64(height)), getHash(height), "height %d", height)
+		}
+		s.Equal(gethcommon.Hash{}, getHash(11), "future blocks have no hash")
+	})
+
+	s.Run("entries older than the window are overwritten", func() {
+		window := int64(evm.BlockHashHistoryServeWindow)
+		// Slot of block 5 gets overwritten by block 5 + window
+		newHeight := 5 + window + 1
+		beginBlock(&deps, newHeight)
+
+		hash, found := deps.EvmKeeper.EvmState.GetBlockHash(deps.Ctx, uint64(5+window))
+		s.True(found)
+		s.Equal(fakeBlockHash(5+window), hash)
+
+		_, found = deps.EvmKeeper.EvmState.GetBlockHash(deps.Ctx, 5)
+		s.False(found, "block 5 is older than the serve window")
+
+		_, found = deps.EvmKeeper.EvmState.GetBlockHash(deps.Ctx, uint64(newHeight))
+		s.False(found, "the current block is not in the ring buffer")
+
+		slot5, err := deps.EvmKeeper.EvmState.BlockHashes.Get(deps.Ctx, 5)
+		s.Require().NoError(err)
+		s.Equal(fakeBlockHash(5+window), slot5)
+	})
+
+	s.Run("genesis block has no parent to record", func() {
+		deps := evmtest.NewTestDeps()
+		beginBlock(&deps, 1)
+		s.Empty(deps.EvmKeeper.EvmState.BlockHashes.Iterate(
+			deps.Ctx, collections.Range[uint64]{},
+		).Keys())
+	})
+}
@@ -38,9 +38,23 @@ func (k Keeper) CallContractWithInput(
 	contractInput []byte,
 	gasLimit uint64,
 ) (evmResp *evm.MsgEthereumTxResponse, err error) {
-	// This is a `defer` pattern to add behavior that runs in the case that the
-	// error is non-nil, creating a concise way to add extra information.
-	defer HandleOutOfGasPanic(&err, "CallContractError")()
+	return k.callContractWithInputAndValue(
+		ctx, evmObj, fromAcc, contract, commit, contractInput, gasLimit, big.NewInt(0),
+	)
+}
+
+// callContractWithInputAndValue is [Keeper.CallContractWithInput] with an
+// amount of wei ("value") sent along with the call.
+func (k Keeper) callContractWithInputAndValue(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	fromAcc gethcommon.Address,
+	contract *gethcommon.Address,
+	commit bool,
+	contractInput []byte,
+	gasLimit uint64,
+	valueWei *big.Int,
+) (*evm.MsgEthereumTxResponse, error) {
 	nonce := k.GetAccNonce(ctx, fromAcc)
 
 	unusedBigInt := big.NewInt(0)
@@ -48,7 +62,7 @@ func (k Keeper) CallContractWithInput(
 		To:               contract,
 		From:             fromAcc,
 		Nonce:            nonce,
-		Value:            unusedBigInt, // amount
+		Value:            valueWei, // amount
 		GasLimit:         gasLimit,
 		GasPrice:         unusedBigInt,
 		GasFeeCap:        unusedBigInt,
@@ -61,6 +75,22 @@ func (k Keeper) CallContractWithInput(
 		SkipFromEOACheck: false,
 	}
 
+	return k.applyContractCall(ctx, evmObj, evmMsg, commit)
+}
+
+// applyContractCall applies a contract call message that does not come from an
+// eth tx, consuming the gas it uses from the gas meter of the context. Failed
+// executions are returned as errors.
+func (k Keeper) applyContractCall(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	evmMsg core.Message,
+	commit bool,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the
+	// error is non-nil, creating a concise way to add extra information.
+	defer HandleOutOfGasPanic(&err, "CallContractError")()
+
 	// Generating TxConfig with an empty tx hash as there is no actual eth tx
 	// sent by a user
 	txConfig := k.TxConfig(ctx, gethcommon.BigToHash(big.NewInt(0)))
@@ -76,7 +106,7 @@ func (k Keeper) CallContractWithInput(
 
 	if evmResp.Failed() {
 		if strings.Contains(evmResp.VmError, vm.ErrOutOfGas.Error()) {
-			err = fmt.Errorf("gas required exceeds allowance (%d)", gasLimit)
+			err = fmt.Errorf("gas required exceeds allowance (%d)", evmMsg.GasLimit)
 			return
 		}
 		if evmResp.VmError == vm.ErrExecutionReverted.Error() {
@@ -88,3 +118,73 @@ func (k Keeper) CallContractWithInput(
 	}
 	return evmResp, nil
 }
+
+// CallContractFromWasm executes a call from a CosmWasm contract to an EVM
+// contract. It implements the "evm_call" custom Wasm message, which lets Wasm
+// contracts invoke Solidity contracts, for example to transfer ERC20 tokens.
+//
+// Parameters:
+//   - ctx: The SDK context of the Wasm execution. Gas used by the EVM is
+//     consumed from its gas meter.
+//   - sender: EVM address of the calling Wasm contract.
+//   - contract: Address of the EVM contract to call.
+//   - contractInput: ABI-encoded input data of the call.
+//   - valueWei: Amount of wei to send with the call.
+//   - gasLimit: Maximum gas the EVM execution may use.
+//
+// The state changes are committed if and only if the call succeeds. A
+// reverted call returns an error with the decoded revert reason. Calls made
+// while an EVM tx is executing, as in EVM -> Wasm precompile -> "evm_call",
+// fail with [evm.ErrNestedEvmCall].
+func (k *Keeper) CallContractFromWasm(
+	ctx sdk.Context,
+	sender gethcommon.Address,
+	contract gethcommon.Address,
+	contractInput []byte,
+	valueWei *big.Int,
+	gasLimit uint64,
+) (*evm.MsgEthereumTxResponse, error) {
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &contract,
+		From:             sender,
+		Nonce:            k.GetAccNonce(ctx, sender),
+		Value:            valueWei,
+		GasLimit:         gasLimit,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             contractInput,
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	// Calls that reach Wasm from the EVM, like the Wasm precompile, run
+	// inside the StateDB of an EVM tx. Committing a second StateDB there, or
+	// the one of the EVM tx before it ends, would corrupt the EVM tx.
+	if k.Bank.StateDB != nil {
+		return nil, evm.ErrNestedEvmCall
+	}
+	stateDB := k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	evmResp, err := k.applyContractCall(ctx, evmObj, evmMsg, true /*commit*/)
+	if err != nil {
+		return nil, err
+	}
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	// Emit tx logs of the call
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+	return evmResp, nil
+}
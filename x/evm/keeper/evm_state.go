@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkstore "github.com/cosmos/cosmos-sdk/store/types"
@@ -39,12 +40,34 @@ type EvmState struct {
 		[]byte,
 	]
 
+	// BaseFee: EIP-1559 base fee in wei per gas. It is updated at the end
+	// of each block, so it holds the base fee of the block being executed and,
+	// in committed state, the base fee of the next block.
+	BaseFee collections.Item[sdkmath.Int]
+
+	// IBCTransferRefunds: Map from the (source port and channel, sequence) of an
+	// in-flight ICS-20 packet sent by the ICS20 precompile with ERC20 tokens ->
+	// EVM address that receives refunds of the packet as ERC20 tokens. Entries
+	// are removed when the packet is acknowledged or times out.
+	IBCTransferRefunds collections.Map[
+		collections.Pair[string, uint64], // "port/channel" + sequence
+		gethcommon.Address,
+	]
+
+	// BlockHashes: Ring buffer of the hashes of the last
+	// [evm.BlockHashHistoryServeWindow] blocks. The key is the block height
+	// modulo the window size, so each block overwrites the hash from
+	// "BlockHashHistoryServeWindow" blocks before it.
+	BlockHashes collections.Map[uint64, gethcommon.Hash]
+
 	// BlockLogSize: EVM tx log size for the block (transient).
 	BlockLogSize collections.ItemTransient[uint64]
 	// BlockTxIndex: EVM tx index for the block (transient).
 	BlockTxIndex collections.ItemTransient[uint64]
 	// BlockBloom: Bloom filters.
 	BlockBloom collections.ItemTransient[[]byte]
+	// BlockGasUsed: EVM gas used in the block (transient).
+	BlockGasUsed collections.ItemTransient[uint64]
 }
 
 func (k *Keeper) EVMState() EvmState { return k.EvmState }
@@ -69,6 +92,20 @@ func NewEvmState(
 			collections.PairKeyEncoder(eth.KeyEncoderEthAddr, eth.KeyEncoderEthHash),
 			eth.ValueEncoderBytes,
 		),
+		BaseFee: collections.NewItem(
+			storeKey, evm.KeyPrefixBaseFee,
+			collections.IntValueEncoder,
+		),
+		IBCTransferRefunds: collections.NewMap(
+			storeKey, evm.KeyPrefixIBCTransferRefunds,
+			collections.PairKeyEncoder(collections.StringKeyEncoder, collections.Uint64KeyEncoder),
+			eth.ValueEncoderEthAddr,
+		),
+		BlockHashes: collections.NewMap(
+			storeKey, evm.KeyPrefixBlockHashes,
+			collections.Uint64KeyEncoder,
+			eth.ValueEncoderEthHash,
+		),
 		BlockLogSize: collections.NewItemTransient(
 			storeKeyTransient,
 			evm.NamespaceBlockLogSize,
@@ -84,6 +121,11 @@ func NewEvmState(
 			evm.NamespaceBlockTxIndex,
 			collections.Uint64ValueEncoder,
 		),
+		BlockGasUsed: collections.NewItemTransient(
+			storeKeyTransient,
+			evm.NamespaceBlockGasUsed,
+			collections.Uint64ValueEncoder,
+		),
 	}
 }
 
@@ -139,6 +181,31 @@ func (k *Keeper) GetState(ctx sdk.Context, addr gethcommon.Address, stateKey get
 	))
 }
 
+// SetBlockHash stores the hash of the block at the given height in the block
+// hash ring buffer.
+func (state EvmState) SetBlockHash(ctx sdk.Context, height uint64, hash gethcommon.Hash) {
+	state.BlockHashes.Insert(ctx, height%evm.BlockHashHistoryServeWindow, hash)
+}
+
+// GetBlockHash returns the hash of the block at the given height from the block
+// hash ring buffer. Only the last [evm.BlockHashHistoryServeWindow] blocks
+// before the current one are served, so "found" is false for the current
+// block, future blocks, and blocks that are older than the window or were
+// committed before the ring buffer existed.
+func (state EvmState) GetBlockHash(
+	ctx sdk.Context, height uint64,
+) (hash gethcommon.Hash, found bool) {
+	currentHeight := uint64(ctx.BlockHeight())
+	if height >= currentHeight || currentHeight-height > evm.BlockHashHistoryServeWindow {
+		return hash, false
+	}
+	hash, err := state.BlockHashes.Get(ctx, height%evm.BlockHashHistoryServeWindow)
+	if err != nil {
+		return hash, false
+	}
+	return hash, true
+}
+
 // GetBlockBloomTransient returns bloom bytes for the current block height
 func (state EvmState) GetBlockBloomTransient(ctx sdk.Context) *big.Int {
 	bloomBz, err := state.BlockBloom.Get(ctx)
@@ -353,6 +353,96 @@ func (s *FunTokenFromCoinSuite) TestConvertCoinToEvmAndBack() {
 // - Test contract funds: 0 NIBI, 0 WNIBI
 // - Alice: 20 NIBI
 // - Module account: 0 NIBI escrowed
+// TestConvertEvmToCoin: Converts bank coins to ERC20 tokens and back with the
+// Cosmos-native "MsgConvertCoinToEvm" and "MsgConvertEvmToCoin".
+func (s *FunTokenFromCoinSuite) TestConvertEvmToCoin() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	funToken := s.fundAndCreateFunToken(deps, 100)
+
+	s.T().Log("Convert bank coin to erc-20 of the sender")
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(30)),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("Convert erc-20 back to bank coin of alice")
+	deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	resp, err := deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:     deps.Sender.NibiruAddr.String(),
+			Erc20Addr:  funToken.Erc20Addr,
+			Amount:     sdk.NewInt(10),
+			ToBankAddr: alice.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NotZero(deps.Ctx.GasMeter().GasConsumed())
+	wantCoin := sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(10))
+	s.Require().Equal(wantCoin.String(), resp.BankCoin.String())
+
+	s.T().Log("Check typed event ConvertEvmToCoin")
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventConvertEvmToCoin{
+			Sender:               deps.Sender.NibiruAddr.String(),
+			Erc20ContractAddress: funToken.Erc20Addr.String(),
+			ToBankAddr:           alice.NibiruAddr.String(),
+			BankCoin:             wantCoin,
+		},
+	)
+
+	// Check 1: module balance
+	moduleBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, authtypes.NewModuleAddress(evm.ModuleName), evm.EVMBankDenom)
+	s.Require().Equal(sdk.NewInt(20), moduleBalance.Amount)
+
+	// Check 2: alice balance
+	aliceBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, alice.NibiruAddr, evm.EVMBankDenom)
+	s.Require().Equal(sdk.NewInt(10), aliceBalance.Amount)
+
+	// Check 3: erc-20 balance and supply, since the tokens are burned
+	evmObj, _ := deps.NewEVM()
+	balance, err := deps.EvmKeeper.ERC20().BalanceOf(funToken.Erc20Addr.Address, deps.Sender.EthAddr, deps.Ctx, evmObj)
+	s.Require().NoError(err)
+	s.Require().Equal("20", balance.String())
+	balance, err = deps.EvmKeeper.ERC20().BalanceOf(funToken.Erc20Addr.Address, evm.EVM_MODULE_ADDRESS, deps.Ctx, evmObj)
+	s.Require().NoError(err)
+	s.Require().Equal("0", balance.String())
+
+	s.Run("sad: insufficient erc-20 funds", func() {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:     deps.Sender.NibiruAddr.String(),
+				Erc20Addr:  funToken.Erc20Addr,
+				Amount:     sdk.NewInt(21),
+				ToBankAddr: alice.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "ERC20.transfer")
+	})
+
+	s.Run("sad: no funtoken mapping", func() {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:     deps.Sender.NibiruAddr.String(),
+				Erc20Addr:  eth.EIP55Addr{Address: alice.EthAddr},
+				Amount:     sdk.NewInt(1),
+				ToBankAddr: alice.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "no FunToken mapping exists")
+	})
+}
+
 func (s *FunTokenFromCoinSuite) TestNativeSendThenPrecompileSend() {
 	deps := evmtest.NewTestDeps()
 	evmObj, _ := deps.NewEVM()
@@ -64,6 +64,27 @@ func (k *Keeper) RefundGas(
 	return nil
 }
 
+// payoutDevGas pays the developer share of the fees for the gas used by an
+// Ethereum tx to the contract it called, if that contract is registered with
+// "x/devgas". Contract creations pay nothing.
+func (k *Keeper) payoutDevGas(
+	ctx sdk.Context,
+	to *gethcommon.Address,
+	gasUsed uint64,
+	weiPerGas *big.Int,
+) error {
+	if k.devGasPayout == nil || to == nil {
+		return nil
+	}
+	feesWei := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), weiPerGas)
+	feesMicronibi := evm.WeiToNative(feesWei)
+	if feesMicronibi.Sign() <= 0 {
+		return nil
+	}
+	feesPaid := sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdkmath.NewIntFromBigInt(feesMicronibi)))
+	return k.devGasPayout.PayoutEvmDevGas(ctx, *to, feesPaid)
+}
+
 // gasToRefund calculates the amount of gas the state machine should refund to
 // the sender.
 // EIP-3529: refunds are capped to gasUsed / 5
@@ -137,11 +158,11 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 // Args:
 //   - txData: Tx data related to gas, effectie gas, nonce, and chain ID
 //     implemented by every Ethereum tx type.
-//   - baseFeeMicronibi:EIP1559 base fee in units of micronibi ("unibi").
+//   - baseFeeWei: EIP1559 base fee in units of wei per gas.
 //   - isCheckTx: Comes from `[sdk.Context].isCheckTx()`
 func VerifyFee(
 	txData evm.TxData,
-	baseFeeMicronibi *big.Int,
+	baseFeeWei *big.Int,
 	ctx sdk.Context,
 ) (sdk.Coins, error) {
 	var (
@@ -181,11 +202,10 @@ func VerifyFee(
 		)
 	}
 
-	if baseFeeMicronibi == nil {
-		baseFeeMicronibi = evm.BASE_FEE_MICRONIBI
+	if baseFeeWei == nil {
+		baseFeeWei = evm.BASE_FEE_WEI
 	}
 
-	baseFeeWei := evm.NativeToWei(baseFeeMicronibi)
 	feeAmtMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(baseFeeWei))
 	bankDenom := evm.EVMBankDenom
 	if feeAmtMicronibi.Sign() == 0 {
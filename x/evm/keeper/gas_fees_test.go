@@ -7,12 +7,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	devgastypes "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
 )
@@ -20,14 +24,14 @@ import (
 // TestVerifyFee asserts that the result of VerifyFee is the effective fee
 // in units of micronibi per gas.
 func (s *Suite) TestVerifyFee() {
-	baseFeeMicronibi := evm.BASE_FEE_MICRONIBI
+	baseFeeWei := evm.BASE_FEE_WEI
 
 	type testCase struct {
-		name             string
-		txData           evm.TxData
-		baseFeeMicronibi *big.Int
-		wantCoinAmt      string
-		wantErr          string
+		name        string
+		txData      evm.TxData
+		baseFeeWei  *big.Int
+		wantCoinAmt string
+		wantErr     string
 	}
 
 	for _, getTestCase := range []func() testCase{
@@ -35,11 +39,11 @@ func (s *Suite) TestVerifyFee() {
 			txData := evmtest.ValidLegacyTx()
 			effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(nil))
 			return testCase{
-				name:             "happy: legacy tx",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      effectiveFeeMicronibi.String(),
-				wantErr:          "",
+				name:        "happy: legacy tx",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: effectiveFeeMicronibi.String(),
+				wantErr:     "",
 			}
 		},
 		func() testCase {
@@ -47,11 +51,11 @@ func (s *Suite) TestVerifyFee() {
 			txData.GasLimit = gethparams.TxGas - 1
 			effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(nil))
 			return testCase{
-				name:             "sad: gas limit lower than global tx gas cost",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      effectiveFeeMicronibi.String(),
-				wantErr:          "gas limit too low",
+				name:        "sad: gas limit lower than global tx gas cost",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: effectiveFeeMicronibi.String(),
+				wantErr:     "gas limit too low",
 			}
 		},
 		func() testCase {
@@ -59,7 +63,6 @@ func (s *Suite) TestVerifyFee() {
 
 			// Set a gas price that would make the gas fee cap "too low", i.e.
 			// lower than the base fee
-			baseFeeWei := evm.NativeToWei(baseFeeMicronibi)
 			lowGasPrice := sdkmath.NewIntFromBigInt(
 				new(big.Int).Sub(baseFeeWei, big.NewInt(1)),
 			)
@@ -68,11 +71,11 @@ func (s *Suite) TestVerifyFee() {
 			effectiveFeeMicronibi := evm.WeiToNative(txData.EffectiveFeeWei(baseFeeWei))
 
 			return testCase{
-				name:             "happy: gas fee cap lower than base fee",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      effectiveFeeMicronibi.String(),
-				wantErr:          "",
+				name:        "happy: gas fee cap lower than base fee",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: effectiveFeeMicronibi.String(),
+				wantErr:     "",
 			}
 		},
 		func() testCase {
@@ -82,7 +85,7 @@ func (s *Suite) TestVerifyFee() {
 			gasPrice := sdkmath.ZeroInt()
 			txData.GasLimit = gethparams.TxGas // needed for intrinsic gas
 			txData.GasPrice = &gasPrice
-			baseFeeMicronibi := big.NewInt(0)
+			baseFeeWei := big.NewInt(0)
 
 			// Expect a cost to be 0
 			wantCoinAmt := "0"
@@ -92,11 +95,11 @@ func (s *Suite) TestVerifyFee() {
 			return testCase{
 				// This is impossible because base fee is 1 unibi, however this
 				// case is technically valid.
-				name:             "happy: the impossible zero case",
-				txData:           txData,
-				baseFeeMicronibi: baseFeeMicronibi,
-				wantCoinAmt:      "0",
-				wantErr:          "",
+				name:        "happy: the impossible zero case",
+				txData:      txData,
+				baseFeeWei:  baseFeeWei,
+				wantCoinAmt: "0",
+				wantErr:     "",
 			}
 		},
 	} {
@@ -104,7 +107,7 @@ func (s *Suite) TestVerifyFee() {
 		ctx := sdk.Context{}.WithIsCheckTx(true)
 		s.Run(tc.name, func() {
 			gotCoins, err := evmkeeper.VerifyFee(
-				tc.txData, tc.baseFeeMicronibi, ctx,
+				tc.txData, tc.baseFeeWei, ctx,
 			)
 			if tc.wantErr != "" {
 				s.Require().ErrorContains(err, tc.wantErr)
@@ -253,3 +256,58 @@ func (s *Suite) TestRefundGas() {
 		})
 	}
 }
+
+// TestPayoutDevGas: Verifies that Ethereum txs that call a contract registered
+// with x/devgas pay the developer share of the fees for the gas used to the
+// withdrawer of the contract.
+func (s *Suite) TestPayoutDevGas() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdkmath.NewInt(1_000_000_000))),
+	))
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.App.BankKeeper, deps.Ctx, auth.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdkmath.NewInt(1_000_000_000))),
+	))
+
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	contractAddr := deployResp.ContractAddr
+
+	withdrawer := evmtest.NewEthPrivAcc().NibiruAddr
+	deps.App.DevGasKeeper.SetFeeShare(deps.Ctx, devgastypes.NewFeeShare(
+		eth.EthAddrToNibiruAddr(contractAddr), deps.Sender.NibiruAddr, withdrawer,
+	))
+
+	input, err := embeds.SmartContract_TestERC20.ABI.Pack(
+		"transfer", evmtest.NewEthPrivAcc().EthAddr, big.NewInt(1000),
+	)
+	s.Require().NoError(err)
+	nonce := deps.EvmKeeper.GetAccNonce(deps.Ctx, deps.Sender.EthAddr)
+	txMsg, gethSigner, krSigner, err := evmtest.GenerateEthTxMsgAndSigner(
+		evm.JsonTxArgs{
+			From:  &deps.Sender.EthAddr,
+			To:    &contractAddr,
+			Nonce: (*hexutil.Uint64)(&nonce),
+			Data:  (*hexutil.Bytes)(&input),
+		}, &deps, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(txMsg.Sign(gethSigner, krSigner))
+
+	evmResp, err := deps.EvmKeeper.EthereumTx(deps.GoCtx(), txMsg)
+	s.Require().NoError(err)
+	s.Require().Empty(evmResp.VmError)
+
+	weiPerGas := txMsg.EffectiveGasPriceWeiPerGas(deps.EvmKeeper.BaseFeeWeiPerGas(deps.Ctx))
+	feesPaid := evm.WeiToNative(new(big.Int).Mul(new(big.Int).SetUint64(evmResp.GasUsed), weiPerGas))
+	wantPayout := deps.App.DevGasKeeper.GetParams(deps.Ctx).DeveloperShares.
+		MulInt(sdkmath.NewIntFromBigInt(feesPaid)).RoundInt()
+	s.Require().True(wantPayout.IsPositive())
+	s.Equal(
+		wantPayout.String(),
+		deps.App.BankKeeper.GetBalance(deps.Ctx, withdrawer, evm.EVMBankDenom).Amount.String(),
+		"expect the developer share of the fees for the gas used",
+	)
+}
@@ -14,10 +14,14 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/NibiruChain/collections"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
@@ -67,7 +71,7 @@ func (k Keeper) EthAccount(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	acct := k.getAccountWithoutBalance(ctx, addrEth)
 	if acct == nil {
-		return nil, fmt.Errorf("account not found for %s", addrEth.Hex())
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "account not found for %s", addrEth.Hex())
 	}
 	balNative := k.Bank.GetBalance(ctx, addrBech32, evm.EVMBankDenom).Amount.BigInt()
 
@@ -144,15 +148,45 @@ func (k Keeper) Balance(goCtx context.Context, req *evm.QueryBalanceRequest) (*e
 	}, nil
 }
 
+// PendingAccount: Implements the gRPC query for
+// "/eth.evm.v1.Query/PendingAccount". PendingAccount retrieves the nonce and
+// the balance in wei of an Ethereum address on the "pending" state, which is
+// the latest state with the mempool txs of the request applied on top of it.
+//
+// Parameters:
+//   - goCtx: The context.Context object representing the request context.
+//   - req: Request containing the Ethereum address and the pending txs.
+//
+// Returns:
+//   - Response containing the pending nonce and balance, and the number of
+//     pending txs that were applied.
+//   - An error if the address is invalid or the pending state cannot be built.
+func (k Keeper) PendingAccount(
+	goCtx context.Context, req *evm.QueryPendingAccountRequest,
+) (*evm.QueryPendingAccountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pendingCtx, appliedTxs, err := k.pendingStateCtx(ctx, k.GetEVMConfig(ctx), req.PendingTxs)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	addr := gethcommon.HexToAddress(req.Address)
+	return &evm.QueryPendingAccountResponse{
+		BalanceWei: evm.NativeToWei(k.GetEvmGasBalance(pendingCtx, addr)).String(),
+		Nonce:      k.GetAccNonce(pendingCtx, addr),
+		AppliedTxs: uint64(appliedTxs),
+	}, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(
 	goCtx context.Context, _ *evm.QueryBaseFeeRequest,
 ) (*evm.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	baseFeeMicronibiPerGas := sdkmath.NewIntFromBigInt(k.BaseFeeMicronibiPerGas(ctx))
-	baseFeeWei := sdkmath.NewIntFromBigInt(
-		evm.NativeToWei(baseFeeMicronibiPerGas.BigInt()),
-	)
+	baseFeeWei := sdkmath.NewIntFromBigInt(k.BaseFeeWeiPerGas(ctx))
+	baseFeeMicronibiPerGas := sdkmath.NewIntFromBigInt(evm.WeiToNative(baseFeeWei.BigInt()))
 	return &evm.QueryBaseFeeResponse{
 		BaseFee:      &baseFeeWei,
 		BaseFeeUnibi: &baseFeeMicronibiPerGas,
@@ -271,10 +305,19 @@ func (k *Keeper) EthCall(
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
+	stateOverrides, blockOverrides, err := req.Overrides()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
 	evmCfg := k.GetEVMConfig(ctx)
+	ctx, _, err = k.pendingStateCtx(ctx, evmCfg, req.PendingTxs)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	evmCfg.BaseFeeWei = blockOverrides.BaseFeeWei(evmCfg.BaseFeeWei)
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetAccNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, args.GetFrom(), stateOverrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, evmCfg.BaseFeeWei)
@@ -286,7 +329,11 @@ func (k *Keeper) EthCall(
 
 	// pass false to not commit StateDB
 	stateDB := statedb.New(ctx, k, txConfig)
+	if err := stateDB.ApplyStateOverride(stateOverrides); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
 	evm := k.NewEVM(ctx, msg, evmCfg, nil /*tracer*/, stateDB)
+	blockOverrides.Apply(&evm.Context)
 	res, err := k.ApplyEvmMsg(ctx, msg, evm, false /*commit*/, txConfig.TxHash)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
@@ -295,6 +342,18 @@ func (k *Keeper) EthCall(
 	return res, nil
 }
 
+// callNonce returns the nonce to use for a simulated call from the given
+// sender. A nonce set in the state overrides takes precedence over the nonce
+// in state.
+func (k Keeper) callNonce(
+	ctx sdk.Context, from gethcommon.Address, stateOverrides evm.StateOverride,
+) uint64 {
+	if account, ok := stateOverrides[from]; ok && account.Nonce != nil {
+		return uint64(*account.Nonce)
+	}
+	return k.GetAccNonce(ctx, from)
+}
+
 // EstimateGas: Implements the gRPC query for "/eth.evm.v1.Query/EstimateGas".
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(
@@ -336,9 +395,18 @@ func (k Keeper) EstimateGasForEvmCallType(
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
+	stateOverrides, blockOverrides, err := req.Overrides()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	ctx, _, err = k.pendingStateCtx(ctx, evmCfg, req.PendingTxs)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	evmCfg.BaseFeeWei = blockOverrides.BaseFeeWei(evmCfg.BaseFeeWei)
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetAccNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, args.GetFrom(), stateOverrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	// Binary search the gas requirement, as it may be higher than the amount used
@@ -424,7 +492,11 @@ func (k Keeper) EstimateGasForEvmCallType(
 		// pass false to not commit StateDB
 		txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash().Bytes()))
 		stateDB := statedb.New(ctx, &k, txConfig)
+		if err := stateDB.ApplyStateOverride(stateOverrides); err != nil {
+			return true, nil, err
+		}
 		evmObj := k.NewEVM(tmpCtx, evmMsg, evmCfg, nil /*tracer*/, stateDB)
+		blockOverrides.Apply(&evmObj.Context)
 		rsp, err = k.ApplyEvmMsg(tmpCtx, evmMsg, evmObj, false /*commit*/, txConfig.TxHash)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
@@ -591,14 +663,9 @@ func (k Keeper) TraceCall(
 		Block: &cmtproto.BlockParams{MaxGas: req.BlockMaxGas},
 	})
 
+	// The EVM config uses the base fee of the height that is being traced.
 	evmCfg := k.GetEVMConfig(ctx)
 
-	// compute and use base fee of the height that is being traced
-	baseFeeMicronibi := k.BaseFeeMicronibiPerGas(ctx)
-	if baseFeeMicronibi != nil {
-		evmCfg.BaseFeeWei = baseFeeMicronibi
-	}
-
 	txConfig := statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash().Bytes()))
 
 	var tracerConfig json.RawMessage
@@ -678,10 +745,7 @@ func (k Keeper) TraceBlock(
 	evmCfg := k.GetEVMConfig(ctx)
 
 	// compute and use base fee of height that is being traced
-	if baseFeeMicronibiPerGas := k.BaseFeeMicronibiPerGas(ctx); baseFeeMicronibiPerGas != nil {
-		baseFeeWeiPerGas := evm.NativeToWei(baseFeeMicronibiPerGas)
-		evmCfg.BaseFeeWei = baseFeeWeiPerGas
-	}
+	evmCfg.BaseFeeWei = k.BaseFeeWeiPerGas(ctx)
 	var tracerConfig json.RawMessage
 	if req.TraceConfig != nil && req.TraceConfig.TracerConfig != nil {
 		// ignore error. default to no traceConfig
@@ -706,6 +770,7 @@ func (k Keeper) TraceBlock(
 		msg, err := core.TransactionToMessage(ethTx, signer, evmCfg.BaseFeeWei)
 		if err != nil {
 			result.Error = err.Error()
+			results = append(results, &result)
 			continue
 		}
 		traceResult, logIndex, err := k.TraceEthTxMsg(ctx, evmCfg, txConfig, *msg, req.TraceConfig, tracerConfig)
@@ -746,6 +811,9 @@ var gethTracerNames = set.New(
 	// "go-ethereum/eth/tracers/native", meaning it cannot be accessed with
 	// the [tracers.DefaultDirectory].New function.
 	evm.TracerStruct,
+	// Nibiru's native tracer for the Cosmos state changes made inside calls to
+	// custom precompiles. Registered in the [tracers.DefaultDirectory].
+	evm.TracerPrecompile,
 )
 
 // TraceEthTxMsg do trace on one transaction, it returns a tuple: (traceResult,
@@ -883,3 +951,65 @@ func (k Keeper) FunTokenMapping(
 
 	return nil, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", req.Token)
 }
+
+// FunTokenMappings: Implements the gRPC query for
+// "/eth.evm.v1.Query/FunTokenMappings". It lists the [evm.FunToken] mappings
+// that match the filters of the request, ordered by their IDs.
+//
+// The count of matching mappings is taken from the pagination of the same pass
+// over the store for offset queries. Queries by page key only start at the key,
+// so they count the mappings in a separate pass, and only if "count_total" is
+// set.
+func (k Keeper) FunTokenMappings(
+	goCtx context.Context, req *evm.QueryFunTokenMappingsRequest,
+) (*evm.QueryFunTokenMappingsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	countByKey := pageReq.Key != nil && pageReq.CountTotal
+	pageReq.CountTotal = pageReq.Key == nil
+
+	funTokens := []evm.FunToken{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), evm.KeyPrefixFunTokens.Prefix())
+	pageRes, err := sdkquery.FilteredPaginate(
+		store, pageReq,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var funToken evm.FunToken
+			if err := k.cdc.Unmarshal(value, &funToken); err != nil {
+				return false, err
+			}
+			if !req.Matches(funToken) {
+				return false, nil
+			}
+			if accumulate {
+				funTokens = append(funTokens, funToken)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	count := pageRes.Total
+	if countByKey {
+		for _, funToken := range k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+			if req.Matches(funToken) {
+				count++
+			}
+		}
+		pageRes.Total = count
+	}
+
+	return &evm.QueryFunTokenMappingsResponse{
+		FunTokens:  funTokens,
+		Pagination: pageRes,
+		Count:      count,
+	}, nil
+}
@@ -8,7 +8,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethparams "github.com/ethereum/go-ethereum/params"
@@ -18,6 +20,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
 type TestCase[In, Out any] struct {
@@ -147,7 +150,7 @@ func (s *Suite) TestQueryEvmAccount() {
 				wantResp = nil
 				return req, wantResp
 			},
-			wantErr: "account not found for",
+			wantErr: "code = NotFound desc = account not found for",
 		},
 		{
 			name: "happy: nonexistent account (bech32 input)",
@@ -159,7 +162,7 @@ func (s *Suite) TestQueryEvmAccount() {
 				wantResp = nil
 				return req, wantResp
 			},
-			wantErr: "account not found for",
+			wantErr: "code = NotFound desc = account not found for",
 		},
 	}
 
@@ -510,6 +513,147 @@ func (s *Suite) TestQueryEthCall() {
 	}
 }
 
+func (s *Suite) TestQueryEthCallWithOverrides() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := evmtest.NewEthPrivAcc().EthAddr
+
+	// Runtime bytecode that returns the current block number:
+	// NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes(gethcommon.FromHex("0x4360005260206000f3"))
+	stateOverrides, err := json.Marshal(evm.StateOverride{
+		contractAddr: {Code: &code},
+	})
+	s.Require().NoError(err)
+	blockNumber := (*hexutil.Big)(big.NewInt(424242))
+	blockOverrides, err := json.Marshal(evm.BlockOverrides{
+		Number: blockNumber,
+	})
+	s.Require().NoError(err)
+	jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+		From: &deps.Sender.EthAddr,
+		To:   &contractAddr,
+	})
+	s.Require().NoError(err)
+
+	s.Run("code and block number are overridden", func() {
+		resp, err := deps.App.EvmKeeper.EthCall(sdk.WrapSDKContext(deps.Ctx), &evm.EthCallRequest{
+			Args:           jsonTxArgs,
+			GasCap:         gethparams.TxGas * 10,
+			StateOverrides: stateOverrides,
+			BlockOverrides: blockOverrides,
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.VmError)
+		s.Equal(gethcommon.BigToHash(blockNumber.ToInt()).Bytes(), resp.Ret)
+	})
+
+	s.Run("overrides are not persisted", func() {
+		s.Nil(deps.EvmKeeper.GetAccount(deps.Ctx, contractAddr))
+	})
+
+	s.Run("gas estimation sees overridden code", func() {
+		resp, err := deps.App.EvmKeeper.EstimateGas(sdk.WrapSDKContext(deps.Ctx), &evm.EthCallRequest{
+			Args:           jsonTxArgs,
+			GasCap:         gethparams.TxGas * 10,
+			StateOverrides: stateOverrides,
+		})
+		s.Require().NoError(err)
+		s.Greater(resp.Gas, gethparams.TxGas)
+	})
+
+	s.Run("sad: invalid state overrides", func() {
+		_, err := deps.App.EvmKeeper.EthCall(sdk.WrapSDKContext(deps.Ctx), &evm.EthCallRequest{
+			Args:           jsonTxArgs,
+			StateOverrides: []byte("invalid"),
+		})
+		s.ErrorContains(err, "invalid state overrides")
+	})
+}
+
+func (s *Suite) TestQuerySimulateV1() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := evmtest.NewEthPrivAcc().EthAddr
+
+	// Runtime bytecode of a counter that increments storage slot 0 and
+	// returns the new value:
+	// PUSH1 0 SLOAD PUSH1 1 ADD DUP1 PUSH1 0 SSTORE
+	// PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes(gethcommon.FromHex("0x6000546001018060005560005260206000f3"))
+	increment := evm.JsonTxArgs{
+		From: &deps.Sender.EthAddr,
+		To:   &contractAddr,
+	}
+	simulate := func(opts evm.SimOpts) (*evm.SimulateV1Response, error) {
+		bz, err := json.Marshal(opts)
+		s.Require().NoError(err)
+		return deps.App.EvmKeeper.SimulateV1(sdk.WrapSDKContext(deps.Ctx), &evm.SimulateV1Request{
+			Opts:   bz,
+			GasCap: 1_000_000,
+		})
+	}
+
+	s.Run("calls observe the state changes of previous calls", func() {
+		resp, err := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{
+					StateOverrides: evm.StateOverride{contractAddr: {Code: &code}},
+					Calls:          []evm.JsonTxArgs{increment, increment},
+				},
+				{
+					Calls: []evm.JsonTxArgs{increment},
+				},
+			},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Blocks, 2)
+
+		height := uint64(deps.Ctx.BlockHeight())
+		s.Equal(height+1, resp.Blocks[0].Number)
+		s.Equal(height+2, resp.Blocks[1].Number)
+
+		var counts []int64
+		for _, block := range resp.Blocks {
+			var gasUsed uint64
+			for _, call := range block.Calls {
+				s.Require().Empty(call.VmError)
+				counts = append(counts, new(big.Int).SetBytes(call.ReturnData).Int64())
+				gasUsed += call.GasUsed
+			}
+			s.Equal(gasUsed, block.GasUsed)
+		}
+		s.Equal([]int64{1, 2, 3}, counts)
+	})
+
+	s.Run("simulation is not persisted", func() {
+		s.Nil(deps.EvmKeeper.GetAccount(deps.Ctx, contractAddr))
+	})
+
+	s.Run("sad: block numbers out of order", func() {
+		_, err := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{BlockOverrides: &evm.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1))}},
+			},
+		})
+		s.ErrorContains(err, "block numbers must be in order")
+	})
+
+	s.Run("sad: invalid nonce with validation", func() {
+		badNonce := hexutil.Uint64(42)
+		args := increment
+		args.Nonce = &badNonce
+		_, err := simulate(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{Calls: []evm.JsonTxArgs{args}}},
+			Validation:      true,
+		})
+		s.ErrorContains(err, "invalid nonce")
+	})
+
+	s.Run("sad: no blocks", func() {
+		_, err := simulate(evm.SimOpts{})
+		s.ErrorContains(err, "no blocks to simulate")
+	})
+}
+
 func (s *Suite) TestQueryBalance() {
 	type In = *evm.QueryBalanceRequest
 	type Out = *evm.QueryBalanceResponse
@@ -588,6 +732,146 @@ func (s *Suite) TestQueryBalance() {
 	}
 }
 
+func (s *Suite) TestQueryPendingState() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(evm.EVMBankDenom, 1_000_000)),
+	))
+	recipient := evmtest.NewEthPrivAcc().EthAddr
+	gasPrice := evm.NativeToWei(evm.BASE_FEE_MICRONIBI)
+	transfer := func(nonce uint64, amountWei *big.Int) *evm.MsgEthereumTx {
+		gasLimit := hexutil.Uint64(gethparams.TxGas)
+		msg := (&evm.JsonTxArgs{
+			From:     &deps.Sender.EthAddr,
+			To:       &recipient,
+			Nonce:    (*hexutil.Uint64)(&nonce),
+			Gas:      &gasLimit,
+			GasPrice: (*hexutil.Big)(gasPrice),
+			Value:    (*hexutil.Big)(amountWei),
+		}).ToMsgEthTx()
+		s.Require().NoError(msg.Sign(deps.GethSigner(), deps.Sender.KeyringSigner))
+		return msg
+	}
+	pendingTxs := []*evm.MsgEthereumTx{
+		transfer(0, evm.NativeToWei(big.NewInt(100))),
+		transfer(1, evm.NativeToWei(big.NewInt(200))),
+		// nonce gap: skipped
+		transfer(3, evm.NativeToWei(big.NewInt(300))),
+	}
+
+	s.Run("pending account of the sender", func() {
+		resp, err := deps.EvmKeeper.PendingAccount(deps.GoCtx(), &evm.QueryPendingAccountRequest{
+			Address:    deps.Sender.EthAddr.Hex(),
+			PendingTxs: pendingTxs,
+		})
+		s.Require().NoError(err)
+		s.EqualValues(2, resp.AppliedTxs)
+		s.EqualValues(2, resp.Nonce)
+		// 1_000_000 - 100 - 200 - 2 * 21000 gas * 1 unibi
+		s.Equal(evm.NativeToWei(big.NewInt(957_700)).String(), resp.BalanceWei)
+	})
+
+	s.Run("txs rejected by the evm consume the nonce and fee", func() {
+		resp, err := deps.EvmKeeper.PendingAccount(deps.GoCtx(), &evm.QueryPendingAccountRequest{
+			Address: deps.Sender.EthAddr.Hex(),
+			// less than 1 micronibi
+			PendingTxs: []*evm.MsgEthereumTx{transfer(0, big.NewInt(123))},
+		})
+		s.Require().NoError(err)
+		s.EqualValues(1, resp.AppliedTxs)
+		s.EqualValues(1, resp.Nonce)
+		s.Equal(evm.NativeToWei(big.NewInt(979_000)).String(), resp.BalanceWei)
+	})
+
+	s.Run("pending account of the recipient", func() {
+		resp, err := deps.EvmKeeper.PendingAccount(deps.GoCtx(), &evm.QueryPendingAccountRequest{
+			Address:    recipient.Hex(),
+			PendingTxs: pendingTxs,
+		})
+		s.Require().NoError(err)
+		s.EqualValues(0, resp.Nonce)
+		s.Equal(evm.NativeToWei(big.NewInt(300)).String(), resp.BalanceWei)
+	})
+
+	s.Run("pending txs are not persisted", func() {
+		s.EqualValues(0, deps.EvmKeeper.GetAccNonce(deps.Ctx, deps.Sender.EthAddr))
+		s.Equal("0", deps.EvmKeeper.GetEvmGasBalance(deps.Ctx, recipient).String())
+	})
+
+	s.Run("eth call on the pending state", func() {
+		value := (*hexutil.Big)(evm.NativeToWei(big.NewInt(250)))
+		jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+			From:  &recipient,
+			To:    &deps.Sender.EthAddr,
+			Value: value,
+		})
+		s.Require().NoError(err)
+
+		resp, err := deps.EvmKeeper.EthCall(deps.GoCtx(), &evm.EthCallRequest{
+			Args:   jsonTxArgs,
+			GasCap: gethparams.TxGas,
+		})
+		s.Require().NoError(err)
+		s.Contains(resp.VmError, "insufficient balance")
+
+		resp, err = deps.EvmKeeper.EthCall(deps.GoCtx(), &evm.EthCallRequest{
+			Args:       jsonTxArgs,
+			GasCap:     gethparams.TxGas,
+			PendingTxs: pendingTxs,
+		})
+		s.Require().NoError(err)
+		s.Empty(resp.VmError)
+
+		gasResp, err := deps.EvmKeeper.EstimateGas(deps.GoCtx(), &evm.EthCallRequest{
+			Args:       jsonTxArgs,
+			GasCap:     gethparams.TxGas,
+			PendingTxs: pendingTxs,
+		})
+		s.Require().NoError(err)
+		s.Equal(gethparams.TxGas, gasResp.Gas)
+	})
+
+	s.Run("sad: invalid address", func() {
+		_, err := deps.EvmKeeper.PendingAccount(deps.GoCtx(), &evm.QueryPendingAccountRequest{
+			Address: InvalidEthAddr(),
+		})
+		s.ErrorContains(err, "InvalidArgument")
+	})
+
+	s.Run("sad: too many pending txs", func() {
+		tooManyTxs := make([]*evm.MsgEthereumTx, evm.MaxPendingTxs+1)
+		for i := range tooManyTxs {
+			tooManyTxs[i] = pendingTxs[0]
+		}
+		_, err := deps.EvmKeeper.PendingAccount(deps.GoCtx(), &evm.QueryPendingAccountRequest{
+			Address:    deps.Sender.EthAddr.Hex(),
+			PendingTxs: tooManyTxs,
+		})
+		s.ErrorContains(err, "too many pending txs")
+
+		_, err = deps.EvmKeeper.EthCall(deps.GoCtx(), &evm.EthCallRequest{
+			GasCap:     gethparams.TxGas,
+			PendingTxs: tooManyTxs,
+		})
+		s.ErrorContains(err, "too many pending txs")
+	})
+
+	s.Run("sad: pending txs use too much gas", func() {
+		gasLimit := hexutil.Uint64(evm.MaxPendingTxsGas/2 + 1)
+		bigTx := (&evm.JsonTxArgs{
+			From: &deps.Sender.EthAddr,
+			To:   &recipient,
+			Gas:  &gasLimit,
+		}).ToMsgEthTx()
+		_, err := deps.EvmKeeper.EstimateGas(deps.GoCtx(), &evm.EthCallRequest{
+			GasCap:     gethparams.TxGas,
+			PendingTxs: []*evm.MsgEthereumTx{bigTx, bigTx},
+		})
+		s.ErrorContains(err, "gas of the pending txs exceeds")
+	})
+}
+
 func (s *Suite) TestQueryBaseFee() {
 	type In = *evm.QueryBaseFeeRequest
 	type Out = *evm.QueryBaseFeeResponse
@@ -606,6 +890,50 @@ func (s *Suite) TestQueryBaseFee() {
 			},
 			wantErr: "",
 		},
+		{
+			name: "happy: base fee rises after a congested block",
+			setup: func(deps *evmtest.TestDeps) {
+				params := deps.EvmKeeper.GetParams(deps.Ctx)
+				params.BaseFeeTargetGas = 1_000_000
+				params.BaseFeeChangeDenominator = 8
+				s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+				deps.EvmKeeper.EvmState.BaseFee.Set(
+					deps.Ctx, sdkmath.NewIntFromBigInt(evm.NativeToWei(big.NewInt(1_000))),
+				)
+				deps.EvmKeeper.EvmState.BlockGasUsed.Set(deps.Ctx, 2_000_000)
+				deps.EvmKeeper.EndBlock(deps.Ctx, abci.RequestEndBlock{})
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				req = &evm.QueryBaseFeeRequest{}
+				feeUnibi := sdkmath.NewInt(1_125)
+				feeWei := sdkmath.NewIntFromBigInt(evm.NativeToWei(feeUnibi.BigInt()))
+				wantResp = &evm.QueryBaseFeeResponse{
+					BaseFee:      &feeWei,
+					BaseFeeUnibi: &feeUnibi,
+				}
+				return req, wantResp
+			},
+			wantErr: "",
+		},
+		{
+			name: "happy: base fee rises from the floor by a fraction of a unibi",
+			setup: func(deps *evmtest.TestDeps) {
+				deps.EvmKeeper.EvmState.BlockGasUsed.Set(deps.Ctx, 2*evm.DefaultBaseFeeTargetGas)
+				deps.EvmKeeper.EndBlock(deps.Ctx, abci.RequestEndBlock{})
+			},
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				req = &evm.QueryBaseFeeRequest{}
+				// 1 unibi * 1.125, rounded down in unibi
+				feeWei := sdkmath.NewInt(1_125_000_000_000)
+				feeUnibi := sdkmath.NewInt(1)
+				wantResp = &evm.QueryBaseFeeResponse{
+					BaseFee:      &feeWei,
+					BaseFeeUnibi: &feeUnibi,
+				}
+				return req, wantResp
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -829,6 +1157,115 @@ func (s *Suite) TestTraceTx() {
 	}
 }
 
+// TestTraceTxPrecompileTracer checks that the "precompileTracer" surfaces the
+// bank send made inside a call to the FunToken precompile.
+func (s *Suite) TestTraceTxPrecompileTracer() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1_000)),
+	))
+
+	to := evmtest.NewEthPrivAcc()
+	input, err := embeds.SmartContract_FunToken.ABI.Pack(
+		string(precompile.FunTokenMethod_bankMsgSend),
+		to.EthAddr.Hex(), eth.EthBaseDenom, big.NewInt(420),
+	)
+	s.Require().NoError(err)
+	nonce := deps.NewStateDB().GetNonce(deps.Sender.EthAddr)
+	gasLimit := hexutil.Uint64(500_000)
+	txMsg, gethSigner, krSigner, err := evmtest.GenerateEthTxMsgAndSigner(
+		evm.JsonTxArgs{
+			From:  &deps.Sender.EthAddr,
+			To:    &precompile.PrecompileAddr_FunToken,
+			Nonce: (*hexutil.Uint64)(&nonce),
+			Gas:   &gasLimit,
+			Data:  (*hexutil.Bytes)(&input),
+		}, &deps, deps.Sender,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(txMsg.Sign(gethSigner, krSigner))
+
+	resp, err := deps.EvmKeeper.TraceTx(deps.GoCtx(), &evm.QueryTraceTxRequest{
+		Msg:         txMsg,
+		TraceConfig: &evm.TraceConfig{Tracer: evm.TracerPrecompile},
+	})
+	s.Require().NoError(err)
+
+	var res evm.PrecompileTraceResult
+	s.Require().NoError(json.Unmarshal(resp.Data, &res), string(resp.Data))
+	s.Require().Len(res.Calls, 1, string(resp.Data))
+	call := res.Calls[0]
+	s.Empty(call.Error)
+	s.Equal(precompile.PrecompileAddr_FunToken, call.Precompile)
+	s.Equal("FunToken", call.Name)
+	s.Equal(deps.Sender.EthAddr, call.From)
+	s.Equal(string(precompile.FunTokenMethod_bankMsgSend), call.Method)
+	s.Require().Len(call.Args, 3)
+	s.Equal(eth.EthBaseDenom, call.Args[1].Value)
+
+	s.ElementsMatch([]evm.BankBalanceDelta{
+		{Address: deps.Sender.NibiruAddr.String(), Denom: eth.EthBaseDenom, Amount: "-420"},
+		{Address: to.NibiruAddr.String(), Denom: eth.EthBaseDenom, Amount: "420"},
+	}, call.BankDeltas)
+	s.Empty(call.WasmMsgs)
+	s.NotEmpty(call.Events)
+}
+
+// TestTraceTxPrecompileTracerRawInput checks that the "precompileTracer"
+// records calls to the custom precompiles whose input is not ABI encoded.
+func (s *Suite) TestTraceTxPrecompileTracerRawInput() {
+	for _, tc := range []struct {
+		precompile gethcommon.Address
+		name       string
+		input      []byte
+	}{
+		{
+			precompile: precompile.PrecompileAddr_P256Verify,
+			name:       "P256Verify",
+			input:      make([]byte, 160),
+		},
+		{
+			precompile: precompile.PrecompileAddr_BlockHashHistory,
+			name:       "BlockHashHistory",
+			input:      make([]byte, 32), // block 0
+		},
+	} {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			nonce := deps.NewStateDB().GetNonce(deps.Sender.EthAddr)
+			gasLimit := hexutil.Uint64(100_000)
+			txMsg, gethSigner, krSigner, err := evmtest.GenerateEthTxMsgAndSigner(
+				evm.JsonTxArgs{
+					From:  &deps.Sender.EthAddr,
+					To:    &tc.precompile,
+					Nonce: (*hexutil.Uint64)(&nonce),
+					Gas:   &gasLimit,
+					Data:  (*hexutil.Bytes)(&tc.input),
+				}, &deps, deps.Sender,
+			)
+			s.Require().NoError(err)
+			s.Require().NoError(txMsg.Sign(gethSigner, krSigner))
+
+			resp, err := deps.EvmKeeper.TraceTx(deps.GoCtx(), &evm.QueryTraceTxRequest{
+				Msg:         txMsg,
+				TraceConfig: &evm.TraceConfig{Tracer: evm.TracerPrecompile},
+			})
+			s.Require().NoError(err)
+
+			var res evm.PrecompileTraceResult
+			s.Require().NoError(json.Unmarshal(resp.Data, &res), string(resp.Data))
+			s.Require().Len(res.Calls, 1, string(resp.Data))
+			call := res.Calls[0]
+			s.Equal(tc.precompile, call.Precompile)
+			s.Equal(tc.name, call.Name)
+			s.Equal(hexutil.Bytes(tc.input), call.Input)
+			s.Empty(call.Method)
+			s.Empty(call.Args)
+		})
+	}
+}
+
 func (s *Suite) TestTraceBlock() {
 	type In = *evm.QueryTraceBlockRequest
 	type Out = string
@@ -1081,3 +1518,91 @@ func (s *Suite) TestQueryFunTokenMapping() {
 		})
 	}
 }
+
+func (s *Suite) TestQueryFunTokenMappings() {
+	deps := evmtest.NewTestDeps()
+	goCtx := sdk.WrapSDKContext(deps.Ctx)
+
+	s.T().Log("Setup: insert FunToken mappings from coins and ERC20s")
+	for i, bankDenom := range []string{"unibi", "ibc/ABC", "ibc/DEF"} {
+		s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsert(
+			deps.Ctx, gethcommon.BigToAddress(big.NewInt(int64(100+i))), bankDenom, true,
+		))
+	}
+	for i := range 2 {
+		erc20 := gethcommon.BigToAddress(big.NewInt(int64(200 + i)))
+		s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsert(
+			deps.Ctx, erc20, "erc20/"+erc20.Hex(), false,
+		))
+	}
+
+	s.Run("all mappings", func() {
+		resp, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{})
+		s.Require().NoError(err)
+		s.Len(resp.FunTokens, 5)
+		s.EqualValues(5, resp.Count)
+	})
+
+	s.Run("paginate with offset and next key", func() {
+		resp, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Len(resp.FunTokens, 2)
+		s.EqualValues(5, resp.Pagination.Total)
+		s.NotEmpty(resp.Pagination.NextKey)
+
+		respByKey, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+		})
+		s.Require().NoError(err)
+		respByOffset, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Offset: 2, Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Len(respByKey.FunTokens, 2)
+		s.Equal(respByOffset.FunTokens, respByKey.FunTokens)
+		s.NotEqual(resp.FunTokens, respByKey.FunTokens)
+		s.EqualValues(5, respByOffset.Count)
+		s.Zero(respByKey.Count, "count by key only if requested")
+
+		respByKey, err = deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 2, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.EqualValues(5, respByKey.Count)
+		s.EqualValues(5, respByKey.Pagination.Total)
+	})
+
+	s.Run("filter by origin", func() {
+		resp, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Origin: evm.FunTokenOrigin_FUN_TOKEN_ORIGIN_ERC20,
+		})
+		s.Require().NoError(err)
+		s.Len(resp.FunTokens, 2)
+		s.EqualValues(2, resp.Count)
+		for _, funToken := range resp.FunTokens {
+			s.False(funToken.IsMadeFromCoin)
+		}
+	})
+
+	s.Run("filter by origin and bank denom prefix", func() {
+		resp, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Origin:          evm.FunTokenOrigin_FUN_TOKEN_ORIGIN_COIN,
+			BankDenomPrefix: "ibc/",
+			Pagination:      &sdkquery.PageRequest{Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Len(resp.FunTokens, 1)
+		s.EqualValues(2, resp.Count)
+		s.True(resp.FunTokens[0].IsMadeFromCoin)
+		s.Contains(resp.FunTokens[0].BankDenom, "ibc/")
+	})
+
+	s.Run("sad: invalid origin", func() {
+		_, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+			Origin: evm.FunTokenOrigin(42),
+		})
+		s.Require().ErrorContains(err, "invalid fun token origin")
+	})
+}
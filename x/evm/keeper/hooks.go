@@ -2,6 +2,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -9,19 +10,42 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcoretypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock hook for the EVM module.
-func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock hook for the EVM module. It records the hash of the parent block
+// in the block hash ring buffer, like the EIP-2935 system call at the start of
+// each block, so that the BLOCKHASH opcode and the block hash history
+// precompile can serve it.
+func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	parentHash := ctx.BlockHeader().LastBlockId.Hash
+	if ctx.BlockHeight() <= 1 || len(parentHash) == 0 {
+		return
+	}
+	k.EvmState.SetBlockHash(
+		ctx, uint64(ctx.BlockHeight()-1), gethcommon.BytesToHash(parentHash),
+	)
+}
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
+// KV store, and sets the base fee of the next block from the gas used in this
+// block.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 	bloom := gethcoretypes.BytesToBloom(k.EvmState.GetBlockBloomTransient(ctx).Bytes())
 	_ = ctx.EventManager().EmitTypedEvent(&evm.EventBlockBloom{
 		Bloom: eth.BloomToHex(bloom),
 	})
+	k.updateBaseFee(ctx)
 	// The bloom logic doesn't update the validator set.
 	return []abci.ValidatorUpdate{}
 }
+
+// updateBaseFee stores the EIP-1559 base fee of the next block, computed from
+// the base fee and the EVM gas used of the current block.
+func (k *Keeper) updateBaseFee(ctx sdk.Context) {
+	baseFeeWei := sdkmath.NewIntFromBigInt(k.BaseFeeWeiPerGas(ctx))
+	gasUsed := k.EvmState.BlockGasUsed.GetOr(ctx, 0)
+	k.EvmState.BaseFee.Set(ctx, k.GetParams(ctx).NextBaseFee(baseFeeWei, gasUsed))
+}
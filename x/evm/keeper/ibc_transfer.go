@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// IBCTransferRefundKey returns the key in [EvmState.IBCTransferRefunds] of the
+// ICS-20 packet with the given source port, source channel, and sequence.
+func IBCTransferRefundKey(
+	sourcePort, sourceChannel string, sequence uint64,
+) collections.Pair[string, uint64] {
+	return collections.Join(sourcePort+"/"+sourceChannel, sequence)
+}
+
+// SetIBCTransferRefund records that refunds of an in-flight ICS-20 packet
+// should be converted back to ERC20 tokens of the "refundTo" EVM address. It
+// is used by the ICS20 precompile when the transferred coins were converted
+// from ERC20 tokens.
+func (k Keeper) SetIBCTransferRefund(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	sequence uint64,
+	refundTo gethcommon.Address,
+) {
+	k.EvmState.IBCTransferRefunds.Insert(
+		ctx, IBCTransferRefundKey(sourcePort, sourceChannel, sequence), refundTo,
+	)
+}
+
+// OnIBCTransferPacketDone handles the end of the lifecycle of an ICS-20
+// packet sent from this chain. It must run after the transfer module handled
+// the acknowledgement or timeout of the packet.
+//
+// If the packet was sent by the ICS20 precompile from ERC20 tokens and its
+// coins were refunded, which happens for timeouts and error acknowledgements,
+// the refunded coins are converted back to ERC20 tokens of the EVM sender. If
+// the conversion fails, the sender keeps the refund as bank coins.
+func (k *Keeper) OnIBCTransferPacketDone(
+	ctx sdk.Context, packet channeltypes.Packet, refunded bool,
+) {
+	key := IBCTransferRefundKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	refundTo, err := k.EvmState.IBCTransferRefunds.Get(ctx, key)
+	if err != nil {
+		// The packet was not sent by the ICS20 precompile from ERC20 tokens.
+		return
+	}
+	_ = k.EvmState.IBCTransferRefunds.Delete(ctx, key)
+	if !refunded {
+		return
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		k.Logger(ctx).Error("failed to unmarshal ICS-20 packet data", "error", err)
+		return
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		k.Logger(ctx).Error("invalid amount in ICS-20 packet data", "amount", data.Amount)
+		return
+	}
+	// The transfer module refunds the coin with the denom as known to this
+	// chain, which is the IBC denom (hash) for vouchers of other chains.
+	refund := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.ConvertCoinToEvm(sdk.WrapSDKContext(cacheCtx), &evm.MsgConvertCoinToEvm{
+		Sender:    data.Sender,
+		BankCoin:  refund,
+		ToEthAddr: eth.EIP55Addr{Address: refundTo},
+	}); err != nil {
+		k.Logger(ctx).Error(
+			"failed to convert ICS-20 refund to ERC20 tokens",
+			"refund", refund.String(), "to", refundTo.Hex(), "error", err,
+		)
+		return
+	}
+	writeCache()
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+func (s *Suite) TestOnIBCTransferPacketDone() {
+	deps := evmtest.NewTestDeps()
+	bankDenom := "ufoo"
+	funtoken := evmtest.CreateFunTokenForBankCoin(deps, bankDenom, &s.Suite)
+
+	newPacket := func(sequence uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(
+			bankDenom, "400", deps.Sender.NibiruAddr.String(), "receiver", "",
+		)
+		return channeltypes.NewPacket(
+			data.GetBytes(), sequence, "transfer", "channel-0", "transfer", "channel-1",
+			clienttypes.NewHeight(1, 100), 0,
+		)
+	}
+	// simulateRefund gives the sender the bank coins that the transfer module
+	// refunds before the hook runs.
+	simulateRefund := func() {
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 400)),
+		))
+	}
+
+	s.Run("refund of a precompile transfer returns as ERC20", func() {
+		packet := newPacket(1)
+		deps.EvmKeeper.SetIBCTransferRefund(deps.Ctx, "transfer", "channel-0", 1, deps.Sender.EthAddr)
+		simulateRefund()
+
+		deps.EvmKeeper.OnIBCTransferPacketDone(deps.Ctx, packet, true)
+
+		evmObj, _ := deps.NewEVM()
+		evmtest.AssertERC20BalanceEqualWithDescription(
+			s.T(), deps, evmObj, funtoken.Erc20Addr.Address, deps.Sender.EthAddr, big.NewInt(400),
+			"expect refund as ERC20",
+		)
+		evmtest.AssertBankBalanceEqualWithDescription(
+			s.T(), deps, bankDenom, deps.Sender.EthAddr, big.NewInt(0), "expect no bank coins",
+		)
+		_, err := deps.EvmKeeper.EvmState.IBCTransferRefunds.Get(
+			deps.Ctx, keeper.IBCTransferRefundKey("transfer", "channel-0", 1),
+		)
+		s.Error(err, "expect the refund record to be deleted")
+	})
+
+	s.Run("successful transfer only clears the record", func() {
+		packet := newPacket(2)
+		deps.EvmKeeper.SetIBCTransferRefund(deps.Ctx, "transfer", "channel-0", 2, deps.Sender.EthAddr)
+
+		deps.EvmKeeper.OnIBCTransferPacketDone(deps.Ctx, packet, false)
+
+		_, err := deps.EvmKeeper.EvmState.IBCTransferRefunds.Get(
+			deps.Ctx, keeper.IBCTransferRefundKey("transfer", "channel-0", 2),
+		)
+		s.Error(err, "expect the refund record to be deleted")
+	})
+
+	s.Run("refund of other transfers stays as bank coins", func() {
+		packet := newPacket(3)
+		simulateRefund()
+
+		deps.EvmKeeper.OnIBCTransferPacketDone(deps.Ctx, packet, true)
+
+		evmtest.AssertBankBalanceEqualWithDescription(
+			s.T(), deps, bankDenom, deps.Sender.EthAddr, big.NewInt(400), "expect bank coins",
+		)
+	})
+}
@@ -39,6 +39,9 @@ type Keeper struct {
 	Bank          *NibiruBankKeeper
 	accountKeeper evm.AccountKeeper
 	stakingKeeper evm.StakingKeeper
+	// devGasPayout: Optional. Set after construction with
+	// [Keeper.SetDevGasPayout] because "x/devgas" depends on this keeper.
+	devGasPayout evm.DevGasPayout
 
 	// tracer: Configures the output type for a geth `vm.EVMLogger`. Tracer types
 	// include "access_list", "json", "struct", and "markdown". If any other
@@ -75,6 +78,12 @@ func NewKeeper(
 	}
 }
 
+// SetDevGasPayout sets the payout of developer gas fees for Ethereum txs that
+// call registered contracts.
+func (k *Keeper) SetDevGasPayout(devGasPayout evm.DevGasPayout) {
+	k.devGasPayout = devGasPayout
+}
+
 // GetEvmGasBalance: Used in the EVM Ante Handler,
 // "github.com/NibiruChain/nibiru/v2/app/evmante": Load account's balance of gas
 // tokens for EVM execution in EVM denom units.
@@ -87,18 +96,16 @@ func (k Keeper) EthChainID(ctx sdk.Context) *big.Int {
 	return appconst.GetEthChainID(ctx.ChainID())
 }
 
-// BaseFeeMicronibiPerGas returns the gas base fee in units of the EVM denom. Note
-// that this function is currently constant/stateless.
-func (k Keeper) BaseFeeMicronibiPerGas(_ sdk.Context) *big.Int {
-	// TODO: (someday maybe):  Consider making base fee dynamic based on
-	// congestion in the previous block.
-	return evm.BASE_FEE_MICRONIBI
-}
-
-// BaseFeeWeiPerGas is the same as BaseFeeMicronibiPerGas, except its in units of
-// wei per gas.
-func (k Keeper) BaseFeeWeiPerGas(_ sdk.Context) *big.Int {
-	return evm.NativeToWei(k.BaseFeeMicronibiPerGas(sdk.Context{}))
+// BaseFeeWeiPerGas returns the EIP-1559 gas base fee of the current block in
+// units of wei per gas. The base fee adjusts to the gas used by each block
+// (see [evm.Params.NextBaseFee]) and defaults to [evm.BASE_FEE_WEI] if it has
+// not been set.
+func (k Keeper) BaseFeeWeiPerGas(ctx sdk.Context) *big.Int {
+	baseFeeWei, err := k.EvmState.BaseFee.Get(ctx)
+	if err != nil {
+		return new(big.Int).Set(evm.BASE_FEE_WEI)
+	}
+	return baseFeeWei.BigInt()
 }
 
 // Logger returns a module-specific logger.
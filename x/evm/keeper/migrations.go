@@ -0,0 +1,46 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// Migrator handles the in-place store migrations of the EVM module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 enables the congestion-responsive EIP-1559 base fee. It sets
+// the base fee parameters to their defaults and seeds the base fee with the
+// previously constant value, [evm.BASE_FEE_WEI].
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := evm.DefaultParams()
+	params.BaseFeeTargetGas = defaults.BaseFeeTargetGas
+	params.BaseFeeChangeDenominator = defaults.BaseFeeChangeDenominator
+	params.MinBaseFee = defaults.MinBaseFee
+	params.MaxBaseFee = defaults.MaxBaseFee
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	m.keeper.EvmState.BaseFee.Set(
+		ctx, params.ClampBaseFee(sdkmath.NewIntFromBigInt(evm.BASE_FEE_WEI)),
+	)
+	return nil
+}
+
+// Migrate2to3 activates the Cancun hard fork of the EVM by switching on the
+// "enable_cancun" parameter.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.EnableCancun = true
+	return m.keeper.SetParams(ctx, params)
+}
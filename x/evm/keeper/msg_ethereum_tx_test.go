@@ -10,6 +10,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/x/evm"
@@ -272,3 +273,49 @@ func (s *Suite) TestEthereumTx_ABCI() {
 		s.EqualValuesf(21000, r.GasWanted, "%d", r.GasWanted)
 	}
 }
+
+// TestCancunOpcodes asserts that the Cancun opcodes TSTORE, TLOAD and MCOPY
+// are available if and only if the "enable_cancun" parameter is on.
+func (s *Suite) TestCancunOpcodes() {
+	// Runtime code: tstore(0, 42); mstore(0, tload(0)); mcopy(32, 0, 32);
+	// return(32, 32)
+	runtimeCode := gethcommon.FromHex("602a60005d60005c6000526020600060205e60206020f3")
+	// Init code: codecopy(0, 12, 23); return(0, 23)
+	initCode := append(gethcommon.FromHex("6017600c60003960176000f3"), runtimeCode...)
+
+	for _, tc := range []struct {
+		name         string
+		enableCancun bool
+		wantErr      string
+	}{
+		{name: "happy: cancun enabled", enableCancun: true},
+		{name: "sad: cancun disabled", enableCancun: false, wantErr: "invalid opcode"},
+	} {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			params := deps.EvmKeeper.GetParams(deps.Ctx)
+			params.EnableCancun = tc.enableCancun
+			s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+
+			sender := deps.Sender.EthAddr
+			contractAddr := crypto.CreateAddress(sender, deps.EvmKeeper.GetAccNonce(deps.Ctx, sender))
+			evmObj, _ := deps.NewEVM()
+			_, err := deps.EvmKeeper.CallContractWithInput(
+				deps.Ctx, evmObj, sender, nil /*contract*/, true /*commit*/, initCode, 1_000_000,
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(runtimeCode, deps.EvmKeeper.GetCode(deps.Ctx, crypto.Keccak256Hash(runtimeCode)))
+
+			evmObj, _ = deps.NewEVM()
+			evmResp, err := deps.EvmKeeper.CallContractWithInput(
+				deps.Ctx, evmObj, sender, &contractAddr, false /*commit*/, nil, 1_000_000,
+			)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(gethcommon.BigToHash(big.NewInt(42)).Bytes(), evmResp.Ret)
+		})
+	}
+}
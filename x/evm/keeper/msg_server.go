@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -92,6 +93,9 @@ func (k *Keeper) EthereumTx(
 	if err = k.RefundGas(ctx, evmMsg.From, refundGas, weiPerGas); err != nil {
 		return nil, sdkioerrors.Wrapf(err, "error refunding leftover gas to sender %s", evmMsg.From)
 	}
+	if err = k.payoutDevGas(ctx, tx.To(), evmResp.GasUsed, weiPerGas); err != nil {
+		return nil, sdkioerrors.Wrap(err, "error paying out dev gas")
+	}
 
 	err = k.EmitEthereumTxEvents(ctx, tx.To(), tx.Type(), *evmMsg, evmResp)
 	if err != nil {
@@ -104,6 +108,7 @@ func (k *Keeper) EthereumTx(
 	}
 
 	k.EvmState.BlockTxIndex.Set(ctx, uint64(txConfig.TxIndex)+1)
+	k.EvmState.BlockGasUsed.Set(ctx, k.EvmState.BlockGasUsed.GetOr(ctx, 0)+evmResp.GasUsed)
 
 	return evmResp, nil
 }
@@ -137,6 +142,9 @@ func (k *Keeper) NewEVM(
 		Time:        evm.ParseBlockTimeUnixU64(ctx),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     evmCfg.BaseFeeWei,
+		// Blob transactions (EIP-4844) are not supported, so the blob base fee
+		// (BLOBBASEFEE opcode) is always zero.
+		BlobBaseFee: big.NewInt(0),
 		Random:      &pseudoRandom,
 	}
 
@@ -153,7 +161,9 @@ func (k *Keeper) NewEVM(
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from a previous height from the same chain epoch
+//  2. The requested height is from a previous height. The last
+//     [evm.BlockHashHistoryServeWindow] block hashes are served from the block
+//     hash ring buffer of the EVM module.
 //  3. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) gethcommon.Hash {
@@ -187,9 +197,15 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve
-			// the hash from the store for the current chain epoch. This only
-			// applies if the current height is greater than the requested
-			// height.
+			// the hash from the store. This only applies if the current height
+			// is greater than the requested height.
+			if hash, found := k.EvmState.GetBlockHash(ctx, height); found {
+				return hash
+			}
+
+			// Blocks committed before the ring buffer existed are only known
+			// to the historical info of x/staking, if still within its
+			// "HistoricalEntries" window.
 			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if !found {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
@@ -357,7 +373,7 @@ func (k *Keeper) ApplyEvmMsg(
 		msg.From,                // sender
 		evmObj.Context.Coinbase, // coinbase
 		msg.To,
-		evm.PRECOMPILE_ADDRS,
+		evm.ActivePrecompileAddrs(evmObj.ChainConfig()),
 		msg.AccessList, // accessList
 	)
 
@@ -753,6 +769,170 @@ func (k Keeper) convertCoinToEvmBornERC20(
 	return &evm.MsgConvertCoinToEvmResponse{}, nil
 }
 
+// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+// the EVM address of the sender to the given recipient as Bank Coins. It is
+// the Cosmos-native equivalent of "IFunToken.sendToBank".
+func (k *Keeper) ConvertEvmToCoin(
+	goCtx context.Context, msg *evm.MsgConvertEvmToCoin,
+) (resp *evm.MsgConvertEvmToCoinResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	toBankAddr := sdk.MustAccAddressFromBech32(msg.ToBankAddr)
+	erc20Addr := msg.Erc20Addr.Address
+
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr))
+	if len(funTokens) != 1 {
+		return nil, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20Addr.Hex())
+	}
+	funToken := funTokens[0]
+
+	// needs to run first to populate the StateDB on the BankKeeperExtension
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	senderEthAddr := eth.NibiruAddrToEthAddr(sender)
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             senderEthAddr,
+		Nonce:            k.GetAccNonce(ctx, senderEthAddr),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	bankCoin, err := k.SendERC20ToBank(
+		ctx, evmObj, funToken, senderEthAddr, msg.Amount.BigInt(), toBankAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the stateDB to the BankKeeperExtension because we don't go through
+	// ApplyEvmMsg at all in this tx.
+	logs := evm.NewLogsFromEth(stateDB.Logs())
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               sender.String(),
+		Erc20ContractAddress: funToken.Erc20Addr.String(),
+		ToBankAddr:           toBankAddr.String(),
+		BankCoin:             bankCoin,
+	})
+
+	// Emit tx logs of the Transfer and Burn events
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: logs})
+	if err == nil {
+		k.updateBlockBloom(
+			ctx, &evm.MsgEthereumTxResponse{Logs: logs}, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)),
+		)
+	}
+
+	return &evm.MsgConvertEvmToCoinResponse{BankCoin: bankCoin}, nil
+}
+
+// SendERC20ToBank converts ERC20 tokens of a FunToken mapping into its Bank
+// Coins and sends them to the "to" account. It implements both
+// "MsgConvertEvmToCoin" and "IFunToken.sendToBank".
+//
+//  1. The "sender" transfers the ERC20 tokens to the EVM module account.
+//  2. For a FunToken made from a coin, the EVM module burns the ERC20 tokens
+//     it received. Otherwise, the ERC20 tokens stay escrowed in the EVM module
+//     account and the EVM module mints the Bank Coins.
+//  3. The EVM module sends the Bank Coins to the "to" account.
+//
+// The Bank Coin amount equals the ERC20 balance increase of the EVM module,
+// which can differ from "amount" for tokens with fees on transfer.
+func (k *Keeper) SendERC20ToBank(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	funToken evm.FunToken,
+	sender gethcommon.Address,
+	amount *big.Int,
+	to sdk.AccAddress,
+) (bankCoin sdk.Coin, err error) {
+	// Amount should be positive
+	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
+		return bankCoin, fmt.Errorf("transfer amount must be positive")
+	}
+	erc20 := funToken.Erc20Addr.Address
+
+	// Caller transfers ERC20 to the EVM module account
+	gotAmount, _, err := k.ERC20().Transfer(
+		erc20,                  /*erc20*/
+		sender,                 /*from*/
+		evm.EVM_MODULE_ADDRESS, /*to*/
+		amount,                 /*value*/
+		ctx,
+		evmObj,
+	)
+	if err != nil {
+		return bankCoin, fmt.Errorf(
+			"error in ERC20.transfer from caller to EVM account: %w: from %s, erc20 %s, amount: %s",
+			err, sender, erc20, amount,
+		)
+	}
+
+	// EVM account mints FunToken.BankDenom to module account
+	bankCoin = sdk.NewCoin(funToken.BankDenom, sdkmath.NewIntFromBigInt(gotAmount))
+	if funToken.IsMadeFromCoin {
+		// If the FunToken mapping was created from a bank coin, then the EVM account
+		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
+		// Since we're sending them away and want accurate total supply tracking, the
+		// tokens need to be burned.
+		_, err := k.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
+		if err != nil {
+			return bankCoin, fmt.Errorf("ERC20.Burn: %w", err)
+		}
+	} else {
+		// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
+		// any operation that has the potential to use Bank send methods. This will
+		// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
+		// recorded if wei (NIBI) is transferred.
+		err = k.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(bankCoin))
+		if err != nil {
+			return bankCoin, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
+				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), sender.Hex(), err,
+			)
+		}
+	}
+
+	// Transfer the bank coin
+	//
+	// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
+	// any operation that has the potential to use Bank send methods. This will
+	// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
+	// recorded if wei (NIBI) is transferred.
+	err = k.Bank.SendCoinsFromModuleToAccount(
+		ctx,
+		evm.ModuleName,
+		to,
+		sdk.NewCoins(bankCoin),
+	)
+	if err != nil {
+		return bankCoin, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
+			evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), sender.Hex(), err,
+		)
+	}
+	return bankCoin, nil
+}
+
 // EmitEthereumTxEvents emits all types of EVM events applicable to a particular execution case
 func (k *Keeper) EmitEthereumTxEvents(
 	ctx sdk.Context,
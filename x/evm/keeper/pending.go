@@ -0,0 +1,98 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// pendingStateCtx returns a branch of the context whose state is the
+// "pending" state: the state of the context with the given mempool txs
+// applied on top of it, in order. The branch is never written back to the
+// context.
+//
+// The txs run on a single [statedb.StateDB], so each tx observes the state
+// changes of the ones before it, and the sender pays for the gas it uses at the
+// effective gas price, as in [Keeper.EthereumTx]. Txs that a block could not
+// include are skipped: txs whose nonce does not follow the nonce of the sender,
+// txs whose fee cap is below the base fee and txs whose sender cannot pay for
+// the gas limit and value. Txs that the EVM rejects, like transfers of less
+// than 1 micronibi, only consume the nonce and the fee for the gas limit, as
+// they do in a block. The queries bound the number and the gas of the txs
+// with [evm.ValidatePendingTxs].
+//
+// Returns:
+//   - pendingCtx: The context of the pending state. It is the given context if
+//     there are no pending txs.
+//   - appliedTxs: The number of txs that were applied.
+func (k *Keeper) pendingStateCtx(
+	ctx sdk.Context, evmCfg statedb.EVMConfig, pendingTxs []*evm.MsgEthereumTx,
+) (pendingCtx sdk.Context, appliedTxs int, err error) {
+	if len(pendingTxs) == 0 {
+		return ctx, 0, nil
+	}
+
+	pendingCtx, _ = ctx.CacheContext()
+	signer := gethcore.MakeSigner(
+		evmCfg.ChainConfig,
+		big.NewInt(ctx.BlockHeight()),
+		evm.ParseBlockTimeUnixU64(ctx),
+	)
+	stateDB := statedb.New(
+		pendingCtx, k, statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())),
+	)
+
+	var logIndex uint
+	for _, tx := range pendingTxs {
+		ethTx := tx.AsTransaction()
+		msg, err := core.TransactionToMessage(ethTx, signer, evmCfg.BaseFeeWei)
+		if err != nil {
+			continue
+		}
+		if msg.Nonce != stateDB.GetNonce(msg.From) ||
+			tx.EffectiveGasCapWei(evmCfg.BaseFeeWei).Cmp(evmCfg.BaseFeeWei) < 0 {
+			continue
+		}
+		weiPerGas := tx.EffectiveGasPriceWeiPerGas(evmCfg.BaseFeeWei)
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), weiPerGas)
+		cost.Add(cost, msg.Value)
+		if stateDB.GetBalance(msg.From).ToBig().Cmp(cost) < 0 {
+			continue
+		}
+
+		stateDB.PrepareNextTx(statedb.TxConfig{
+			BlockHash: gethcommon.BytesToHash(ctx.HeaderHash()),
+			TxHash:    ethTx.Hash(),
+			TxIndex:   uint(appliedTxs),
+			LogIndex:  logIndex,
+		})
+		snapshot := stateDB.Snapshot()
+		evmObj := k.NewEVM(pendingCtx, *msg, evmCfg, nil /*tracer*/, stateDB)
+		gasUsed := msg.GasLimit
+		evmResp, err := k.ApplyEvmMsg(pendingCtx, *msg, evmObj, false /*commit*/, ethTx.Hash())
+		if err != nil {
+			// The ante handler still consumes the nonce and the fee of the gas
+			// limit of a tx that fails this way.
+			stateDB.RevertToSnapshot(snapshot)
+			stateDB.SetNonce(msg.From, msg.Nonce+1)
+		} else {
+			gasUsed = evmResp.GasUsed
+			logIndex += uint(len(evmResp.Logs))
+		}
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), weiPerGas)
+		stateDB.AddBalanceSigned(msg.From, fee.Neg(fee))
+		appliedTxs++
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return ctx, 0, err
+	}
+	return pendingCtx, appliedTxs, nil
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// SimulateV1: Implements the gRPC query for "/eth.evm.v1.Query/SimulateV1".
+// SimulateV1 executes the calls of the "eth_simulateV1" JSON-RPC method.
+//
+// The simulated blocks are built on top of the block of the query context.
+// All calls of all blocks run in order on a single [statedb.StateDB] that is
+// never committed, so each call observes the state changes of the calls
+// before it while nothing is persisted.
+//
+// Parameters:
+//   - goCtx: Request context with information about the current block.
+//   - req: JSON-encoded [evm.SimOpts] and the gas available to all calls.
+//
+// Returns:
+//   - The result of each call grouped by simulated block.
+//   - An error if the options are invalid or a call cannot be executed, for
+//     example because of an invalid nonce or insufficient funds when
+//     validation is enabled.
+func (k *Keeper) SimulateV1(
+	goCtx context.Context, req *evm.SimulateV1Request,
+) (*evm.SimulateV1Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	opts, err := req.SimOpts()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	var (
+		evmCfg        = k.GetEVMConfig(ctx)
+		stateDB       = statedb.New(ctx, k, statedb.NewEmptyTxConfig(gethcommon.Hash{}))
+		blockGasLimit = eth.BlockGasLimit(ctx)
+		gasRemaining  = req.GasCap
+		prevNumber    = uint64(ctx.BlockHeight())
+		prevTime      = evm.ParseBlockTimeUnixU64(ctx)
+	)
+	if gasRemaining == 0 {
+		gasRemaining = math.MaxUint64 / 2
+	}
+	if blockGasLimit == 0 {
+		blockGasLimit = gasRemaining
+	}
+
+	blocks := make([]evm.SimulatedBlock, 0, len(opts.BlockStateCalls))
+	for i, simBlock := range opts.BlockStateCalls {
+		number, timestamp, err := simBlockNumberAndTime(
+			simBlock.BlockOverrides, prevNumber, prevTime,
+		)
+		if err != nil {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "block %d: %s", i, err)
+		}
+		prevNumber, prevTime = number, timestamp
+
+		blockCfg := evmCfg
+		blockCfg.BaseFeeWei = simBlock.BlockOverrides.BaseFeeWei(evmCfg.BaseFeeWei)
+		if simBlock.BlockOverrides != nil && simBlock.BlockOverrides.FeeRecipient != nil {
+			blockCfg.BlockCoinbase = *simBlock.BlockOverrides.FeeRecipient
+		}
+
+		if err := stateDB.ApplyStateOverride(simBlock.StateOverrides); err != nil {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "block %d: %s", i, err)
+		}
+
+		block := evm.SimulatedBlock{
+			Number:       number,
+			Timestamp:    timestamp,
+			GasLimit:     blockGasLimit,
+			FeeRecipient: blockCfg.BlockCoinbase.Hex(),
+			BaseFee:      sdkmath.NewIntFromBigInt(blockCfg.BaseFeeWei),
+			Calls:        make([]evm.SimulatedCallResult, 0, len(simBlock.Calls)),
+		}
+		var logIndex uint
+		for j, args := range simBlock.Calls {
+			callRes, err := k.simulateCall(
+				ctx, stateDB, blockCfg, args, opts.Validation,
+				simTxEnv{
+					number:    number,
+					timestamp: timestamp,
+					txIndex:   uint(j),
+					logIndex:  logIndex,
+					gasLimit:  min(blockGasLimit-block.GasUsed, gasRemaining),
+				},
+			)
+			if err != nil {
+				return nil, grpcstatus.Errorf(
+					grpccodes.InvalidArgument, "block %d, call %d: %s", i, j, err,
+				)
+			}
+			block.GasUsed += callRes.GasUsed
+			gasRemaining -= callRes.GasUsed
+			logIndex += uint(len(callRes.Logs))
+			block.Calls = append(block.Calls, *callRes)
+		}
+		blocks = append(blocks, block)
+	}
+
+	return &evm.SimulateV1Response{Blocks: blocks}, nil
+}
+
+// simTxEnv is the block and tx position of a simulated call.
+type simTxEnv struct {
+	number    uint64
+	timestamp uint64
+	txIndex   uint
+	logIndex  uint
+	// gasLimit is the gas left in the simulated block and the simulation as
+	// a whole. It is the default gas limit of calls that do not specify one.
+	gasLimit uint64
+}
+
+// simulateCall executes a single call of "eth_simulateV1" on the given
+// [statedb.StateDB] without committing it.
+func (k *Keeper) simulateCall(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	evmCfg statedb.EVMConfig,
+	args evm.JsonTxArgs,
+	validation bool,
+	env simTxEnv,
+) (*evm.SimulatedCallResult, error) {
+	from := args.GetFrom()
+	stateNonce := stateDB.GetNonce(from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&stateNonce)
+	}
+	if args.Gas == nil {
+		args.Gas = (*hexutil.Uint64)(&env.gasLimit)
+	} else if uint64(*args.Gas) > env.gasLimit {
+		return nil, fmt.Errorf(
+			"gas limit %d exceeds the gas remaining in the block (%d)",
+			uint64(*args.Gas), env.gasLimit,
+		)
+	}
+
+	msg, err := args.ToMessage(env.gasLimit, evmCfg.BaseFeeWei)
+	if err != nil {
+		return nil, err
+	}
+
+	var feeWei *big.Int
+	if validation {
+		if msg.Nonce != stateNonce {
+			return nil, fmt.Errorf(
+				"invalid nonce for %s: have %d, want %d", from.Hex(), msg.Nonce, stateNonce,
+			)
+		}
+		if msg.GasFeeCap.Cmp(evmCfg.BaseFeeWei) < 0 {
+			return nil, fmt.Errorf(
+				"max fee per gas (%s) less than block base fee (%s)",
+				msg.GasFeeCap, evmCfg.BaseFeeWei,
+			)
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+		cost.Add(cost, msg.Value)
+		if balance := stateDB.GetBalance(from).ToBig(); balance.Cmp(cost) < 0 {
+			return nil, fmt.Errorf(
+				"insufficient funds for gas * price + value: address %s have %s want %s",
+				from.Hex(), balance, cost,
+			)
+		}
+		feeWei = msg.GasPrice
+	}
+
+	txHash := args.ToMsgEthTx().AsTransaction().Hash()
+	stateDB.PrepareNextTx(statedb.TxConfig{
+		TxHash:   txHash,
+		TxIndex:  env.txIndex,
+		LogIndex: env.logIndex,
+	})
+	evmObj := k.NewEVM(ctx, msg, evmCfg, nil /*tracer*/, stateDB)
+	evmObj.Context.BlockNumber = new(big.Int).SetUint64(env.number)
+	evmObj.Context.Time = env.timestamp
+
+	evmResp, err := k.ApplyEvmMsg(ctx, msg, evmObj, false /*commit*/, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if feeWei != nil {
+		// Charge the sender for the gas used as a real tx would be.
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(evmResp.GasUsed), feeWei)
+		stateDB.AddBalanceSigned(from, fee.Neg(fee))
+	}
+
+	for i := range evmResp.Logs {
+		evmResp.Logs[i].BlockNumber = env.number
+	}
+	callRes := &evm.SimulatedCallResult{
+		ReturnData: evmResp.Ret,
+		Logs:       evmResp.Logs,
+		GasUsed:    evmResp.GasUsed,
+		VmError:    evmResp.VmError,
+	}
+	if evmResp.VmError == vm.ErrExecutionReverted.Error() {
+		if reason, err := abi.UnpackRevert(evmResp.Ret); err == nil {
+			callRes.RevertReason = reason
+		}
+	}
+	return callRes, nil
+}
+
+// simBlockNumberAndTime returns the number and timestamp of a simulated block.
+// Unless overridden, each simulated block directly follows the previous one
+// and is one second later. Overrides must keep both values strictly
+// increasing.
+func simBlockNumberAndTime(
+	overrides *evm.BlockOverrides, prevNumber, prevTime uint64,
+) (number, timestamp uint64, err error) {
+	number, timestamp = prevNumber+1, prevTime+1
+	if overrides == nil {
+		return number, timestamp, nil
+	}
+	if overrides.Number != nil {
+		n := overrides.Number.ToInt()
+		if !n.IsUint64() || n.Uint64() <= prevNumber {
+			return 0, 0, fmt.Errorf(
+				"block numbers must be in order: %s <= %d", n, prevNumber,
+			)
+		}
+		number = n.Uint64()
+	}
+	if overrides.Time != nil {
+		if uint64(*overrides.Time) <= prevTime {
+			return 0, 0, fmt.Errorf(
+				"block timestamps must be in order: %d <= %d", uint64(*overrides.Time), prevTime,
+			)
+		}
+		timestamp = uint64(*overrides.Time)
+	}
+	return number, timestamp, nil
+}
@@ -8,14 +8,14 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
-	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
 
 func (k *Keeper) GetEVMConfig(ctx sdk.Context) statedb.EVMConfig {
+	params := k.GetParams(ctx)
 	return statedb.EVMConfig{
-		Params:        k.GetParams(ctx),
-		ChainConfig:   evm.EthereumConfig(appconst.GetEthChainID(ctx.ChainID())),
+		Params:        params,
+		ChainConfig:   params.EthereumConfig(appconst.GetEthChainID(ctx.ChainID())),
 		BlockCoinbase: k.GetCoinbaseAddress(ctx),
 		BaseFeeWei:    k.BaseFeeWeiPerGas(ctx),
 	}
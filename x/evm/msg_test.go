@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -799,6 +800,18 @@ func (s *MsgsSuite) TestFromEthereumTx() {
 			s.Require().NoError(err)
 			return tx
 		}},
+		{"fail, blob txs are not supported - BlobTx", false, func() *gethcore.Transaction {
+			tx := gethcore.NewTx(&gethcore.BlobTx{
+				Nonce:      0,
+				To:         s.to,
+				Value:      uint256.NewInt(1),
+				Gas:        21000,
+				BlobHashes: []common.Hash{{0x01}},
+			})
+			tx, err := gethcore.SignTx(tx, gethcore.NewCancunSigner(s.chainID), ethPriv)
+			s.Require().NoError(err)
+			return tx
+		}},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,109 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// StateOverride is the collection of overridden accounts used to simulate
+// execution against hypothetical state in "eth_call" and "eth_estimateGas".
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.14.13/internal/ethapi/api.go#L965
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate checks that no account overrides both "state" and "stateDiff" and
+// that precompiled contracts are not overridden.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		for _, precompileAddr := range PRECOMPILE_ADDRS {
+			if addr == precompileAddr {
+				return fmt.Errorf("cannot override precompile at address %s", addr.Hex())
+			}
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override when simulating
+// execution in "eth_call" and "eth_estimateGas".
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.14.13/internal/ethapi/api.go#L1026
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Apply overrides the given header fields into the given block context.
+func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		blockCtx.BlockNumber = diff.Number.ToInt()
+	}
+	if diff.Time != nil {
+		blockCtx.Time = uint64(*diff.Time)
+	}
+	if diff.FeeRecipient != nil {
+		blockCtx.Coinbase = *diff.FeeRecipient
+	}
+	if diff.BaseFeePerGas != nil {
+		blockCtx.BaseFee = diff.BaseFeePerGas.ToInt()
+	}
+}
+
+// BaseFeeWei returns the overridden base fee if set and the given default
+// otherwise.
+func (diff *BlockOverrides) BaseFeeWei(defaultBaseFee *big.Int) *big.Int {
+	if diff == nil || diff.BaseFeePerGas == nil {
+		return defaultBaseFee
+	}
+	return diff.BaseFeePerGas.ToInt()
+}
+
+// Overrides decodes the JSON-encoded state and block overrides carried by the
+// request. Either return value is nil when the request does not set it.
+func (req *EthCallRequest) Overrides() (
+	stateOverrides StateOverride, blockOverrides *BlockOverrides, err error,
+) {
+	if len(req.StateOverrides) > 0 {
+		if err := json.Unmarshal(req.StateOverrides, &stateOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := stateOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(req.BlockOverrides) > 0 {
+		blockOverrides = new(BlockOverrides)
+		if err := json.Unmarshal(req.BlockOverrides, blockOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid block overrides: %w", err)
+		}
+	}
+	return stateOverrides, blockOverrides, nil
+}
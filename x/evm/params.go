@@ -21,8 +21,18 @@ const (
 	// NIBI on Nibru EVM, implying that the EVMBankDenom is "unibi", the coin
 	// base of the NIBI token.
 	EVMBankDenom = appconst.BondDenom
+
+	// DefaultBaseFeeTargetGas: Default EVM gas used per block at which the
+	// base fee stays constant.
+	DefaultBaseFeeTargetGas uint64 = 15_000_000
+	// DefaultBaseFeeChangeDenominator: Default bound on the change of the base
+	// fee between blocks, matching Ethereum (at most 12.5% per block).
+	DefaultBaseFeeChangeDenominator uint64 = 8
 )
 
+// DefaultMaxBaseFee: Default ceiling of the base fee in micronibi per gas.
+var DefaultMaxBaseFee = sdkmath.NewInt(10_000)
+
 // DefaultParams returns default evm parameters
 // ExtraEIPs is empty to prevent overriding the latest hard fork instruction set
 func DefaultParams() Params {
@@ -31,6 +41,12 @@ func DefaultParams() Params {
 		// EVMChannels: Unused but intended for use with future IBC functionality
 		EVMChannels:       []string{},
 		CreateFuntokenFee: sdkmath.NewIntWithDecimal(10_000, 6), // 10_000 NIBI
+
+		BaseFeeTargetGas:         DefaultBaseFeeTargetGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		MinBaseFee:               sdkmath.NewIntFromBigInt(BASE_FEE_MICRONIBI),
+		MaxBaseFee:               DefaultMaxBaseFee,
+		EnableCancun:             true,
 	}
 }
 
@@ -57,10 +73,97 @@ func (p Params) Validate() error {
 	if err := validateEIPs(p.ExtraEIPs); err != nil {
 		return err
 	}
+	if err := p.validateBaseFee(); err != nil {
+		return err
+	}
 
 	return validateChannels(p.EVMChannels)
 }
 
+// validateBaseFee checks the parameters of the EIP-1559 base fee adjustment.
+func (p Params) validateBaseFee() error {
+	if p.BaseFeeTargetGas > 0 && p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base_fee_change_denominator must be positive when base_fee_target_gas is set")
+	}
+	minBaseFee, maxBaseFee := p.BaseFeeBounds()
+	if minBaseFee.IsNegative() {
+		return fmt.Errorf("min_base_fee cannot be negative: %s", minBaseFee)
+	}
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max_base_fee cannot be negative: %s", maxBaseFee)
+	}
+	if maxBaseFee.IsPositive() && maxBaseFee.LT(minBaseFee) {
+		return fmt.Errorf(
+			"max_base_fee (%s) cannot be less than min_base_fee (%s)", maxBaseFee, minBaseFee,
+		)
+	}
+	return nil
+}
+
+// BaseFeeBounds returns the floor and ceiling of the base fee in micronibi per
+// gas, treating unset values as zero. A zero ceiling means there is no upper
+// bound.
+func (p Params) BaseFeeBounds() (minBaseFee, maxBaseFee sdkmath.Int) {
+	minBaseFee, maxBaseFee = sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	if !p.MinBaseFee.IsNil() {
+		minBaseFee = p.MinBaseFee
+	}
+	if !p.MaxBaseFee.IsNil() {
+		maxBaseFee = p.MaxBaseFee
+	}
+	return minBaseFee, maxBaseFee
+}
+
+// NextBaseFee computes the EIP-1559 base fee of the next block in wei per gas
+// from the base fee in wei per gas and the EVM gas used of the current block.
+//
+// The base fee moves toward demand: it rises by up to
+// 1/BaseFeeChangeDenominator when the block uses more gas than
+// BaseFeeTargetGas and falls by up to the same fraction when the block uses
+// less. The result is clamped to [MinBaseFee, MaxBaseFee]. When
+// BaseFeeTargetGas is zero, the base fee only gets clamped.
+//
+// The computation is in wei rather than micronibi so that the change stays
+// within the bound at small base fees, like the 1 unibi floor, instead of
+// being rounded to whole micronibi. As in go-ethereum, an increase is always
+// at least 1 wei.
+func (p Params) NextBaseFee(baseFeeWei sdkmath.Int, gasUsed uint64) sdkmath.Int {
+	next := baseFeeWei
+	target := p.BaseFeeTargetGas
+	if target > 0 && p.BaseFeeChangeDenominator > 0 && gasUsed != target {
+		var gasDelta uint64
+		if gasUsed > target {
+			gasDelta = gasUsed - target
+		} else {
+			gasDelta = target - gasUsed
+		}
+		feeDelta := baseFeeWei.
+			Mul(sdkmath.NewIntFromUint64(gasDelta)).
+			Quo(sdkmath.NewIntFromUint64(target)).
+			Quo(sdkmath.NewIntFromUint64(p.BaseFeeChangeDenominator))
+		if gasUsed > target {
+			next = baseFeeWei.Add(sdkmath.MaxInt(feeDelta, sdkmath.OneInt()))
+		} else {
+			next = baseFeeWei.Sub(feeDelta)
+		}
+	}
+
+	return p.ClampBaseFee(next)
+}
+
+// ClampBaseFee restricts the given base fee in wei per gas to [MinBaseFee,
+// MaxBaseFee], where the bounds are converted from micronibi to wei.
+func (p Params) ClampBaseFee(baseFeeWei sdkmath.Int) sdkmath.Int {
+	minBaseFee, maxBaseFee := p.BaseFeeBounds()
+	minBaseFeeWei := sdkmath.NewIntFromBigInt(NativeToWei(minBaseFee.BigInt()))
+	maxBaseFeeWei := sdkmath.NewIntFromBigInt(NativeToWei(maxBaseFee.BigInt()))
+	baseFeeWei = sdkmath.MaxInt(baseFeeWei, minBaseFeeWei)
+	if maxBaseFeeWei.IsPositive() {
+		baseFeeWei = sdkmath.MinInt(baseFeeWei, maxBaseFeeWei)
+	}
+	return baseFeeWei
+}
+
 // EIPs returns the ExtraEIPS as a int slice
 func (p Params) EIPs() []int {
 	eips := make([]int, len(p.ExtraEIPs))
@@ -0,0 +1,148 @@
+package evm_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+func TestParamsNextBaseFee(t *testing.T) {
+	// unibi is 1 micronibi in wei. The base fee is in wei per gas, while its
+	// bounds are in micronibi per gas.
+	const unibi int64 = 1_000_000_000_000
+
+	params := evm.DefaultParams()
+	params.BaseFeeTargetGas = 1_000_000
+	params.BaseFeeChangeDenominator = 8
+	params.MinBaseFee = sdkmath.NewInt(10)
+	params.MaxBaseFee = sdkmath.NewInt(2_000)
+
+	for _, tc := range []struct {
+		name    string
+		params  evm.Params
+		baseFee int64
+		gasUsed uint64
+		want    int64
+	}{
+		{name: "at target: unchanged", params: params, baseFee: 1_000 * unibi, gasUsed: 1_000_000, want: 1_000 * unibi},
+		{name: "full block: +12.5%", params: params, baseFee: 1_000 * unibi, gasUsed: 2_000_000, want: 1_125 * unibi},
+		{name: "empty block: -12.5%", params: params, baseFee: 1_000 * unibi, gasUsed: 0, want: 875 * unibi},
+		{name: "above target: fraction of a micronibi", params: params, baseFee: 10 * unibi, gasUsed: 1_000_001, want: 10*unibi + 1_250_000},
+		{name: "below target: clamped to min", params: params, baseFee: 10 * unibi, gasUsed: 0, want: 10 * unibi},
+		{name: "above target: clamped to max", params: params, baseFee: 1_990 * unibi, gasUsed: 2_000_000, want: 2_000 * unibi},
+		{
+			name: "above target: rise is at least 1 wei",
+			params: func() evm.Params {
+				p := params
+				p.MinBaseFee = sdkmath.ZeroInt()
+				return p
+			}(),
+			baseFee: 7, gasUsed: 1_000_001, want: 8,
+		},
+		{
+			name:    "default params: full block rises from the floor by 12.5%",
+			params:  evm.DefaultParams(),
+			baseFee: unibi, gasUsed: 2 * evm.DefaultBaseFeeTargetGas, want: unibi + unibi/8,
+		},
+		{
+			name:    "default params: empty block decays below 8 unibi",
+			params:  evm.DefaultParams(),
+			baseFee: 4 * unibi, gasUsed: 0, want: 3*unibi + unibi/2,
+		},
+		{
+			name:    "default params: empty block decays back to the floor",
+			params:  evm.DefaultParams(),
+			baseFee: unibi + unibi/8, gasUsed: 0, want: unibi,
+		},
+		{
+			name: "adjustment disabled: only clamped",
+			params: func() evm.Params {
+				p := params
+				p.BaseFeeTargetGas = 0
+				return p
+			}(),
+			baseFee: 5 * unibi, gasUsed: 5_000_000, want: 10 * unibi,
+		},
+		{
+			name: "no max base fee",
+			params: func() evm.Params {
+				p := params
+				p.MaxBaseFee = sdkmath.ZeroInt()
+				return p
+			}(),
+			baseFee: 8_000 * unibi, gasUsed: 2_000_000, want: 9_000 * unibi,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.params.NextBaseFee(sdkmath.NewInt(tc.baseFee), tc.gasUsed)
+			require.Equal(t, sdkmath.NewInt(tc.want).String(), got.String())
+		})
+	}
+
+	t.Run("default params: full blocks compound from the floor", func(t *testing.T) {
+		params := evm.DefaultParams()
+		baseFee := sdkmath.NewInt(unibi)
+		for range 3 {
+			baseFee = params.NextBaseFee(baseFee, 2*evm.DefaultBaseFeeTargetGas)
+		}
+		// 1 unibi * 1.125^3
+		require.Equal(t, "1423828125000", baseFee.String())
+		for range 3 {
+			baseFee = params.NextBaseFee(baseFee, 0)
+		}
+		// 1 unibi * 1.125^3 * 0.875^3 is below the floor.
+		require.Equal(t, sdkmath.NewInt(unibi).String(), baseFee.String())
+	})
+}
+
+func TestParamsValidateBaseFee(t *testing.T) {
+	require.NoError(t, evm.DefaultParams().Validate())
+
+	for _, tc := range []struct {
+		name    string
+		modify  func(p *evm.Params)
+		wantErr string
+	}{
+		{
+			name:    "zero denominator with target gas",
+			modify:  func(p *evm.Params) { p.BaseFeeChangeDenominator = 0 },
+			wantErr: "base_fee_change_denominator must be positive",
+		},
+		{
+			name:    "negative min base fee",
+			modify:  func(p *evm.Params) { p.MinBaseFee = sdkmath.NewInt(-1) },
+			wantErr: "min_base_fee cannot be negative",
+		},
+		{
+			name: "max base fee below min base fee",
+			modify: func(p *evm.Params) {
+				p.MinBaseFee = sdkmath.NewInt(100)
+				p.MaxBaseFee = sdkmath.NewInt(99)
+			},
+			wantErr: "cannot be less than min_base_fee",
+		},
+		{
+			name: "unset base fee params",
+			modify: func(p *evm.Params) {
+				p.BaseFeeTargetGas = 0
+				p.BaseFeeChangeDenominator = 0
+				p.MinBaseFee = sdkmath.Int{}
+				p.MaxBaseFee = sdkmath.Int{}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := evm.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package precompile
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+var _ vm.PrecompiledContract = (*precompileBlockHashHistory)(nil)
+
+// PrecompileAddr_BlockHashHistory is the address of the block hash history
+// system contract, as defined by EIP-2935.
+var PrecompileAddr_BlockHashHistory = gethcommon.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935")
+
+// BlockHashHistoryGas is the fixed gas cost of a call to the block hash history
+// precompile. It matches the cold storage read that dominates the cost of the
+// EIP-2935 system contract.
+const BlockHashHistoryGas uint64 = 2_100
+
+func (p precompileBlockHashHistory) Address() gethcommon.Address {
+	return PrecompileAddr_BlockHashHistory
+}
+
+func (p precompileBlockHashHistory) RequiredGas(_ []byte) uint64 {
+	return BlockHashHistoryGas
+}
+
+// Run returns the hash of a recent block following the "get" operation of
+// EIP-2935. Unlike the BLOCKHASH opcode, which only serves the last 256
+// blocks, it serves the last [evm.BlockHashHistoryServeWindow] blocks.
+//
+// The input is the block number encoded as 32 bytes, which is what
+// "abi.encode(blockNumber)" produces in Solidity. The output is the block
+// hash as 32 bytes. The call reverts if the input is not 32 bytes long or if
+// the block is the current block, a future block, or older than the window.
+func (p precompileBlockHashHistory) Run(
+	evmObj *vm.EVM,
+	trueCaller gethcommon.Address,
+	contract *vm.Contract,
+	readonly bool,
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	input := contract.Input
+	if len(input) != 32 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	blockNumber := new(big.Int).SetBytes(input)
+	currentBlock := evmObj.Context.BlockNumber
+	if blockNumber.Cmp(currentBlock) >= 0 ||
+		new(big.Int).Sub(currentBlock, blockNumber).Uint64() > evm.BlockHashHistoryServeWindow {
+		return nil, vm.ErrExecutionReverted
+	}
+	return evmObj.Context.GetHash(blockNumber.Uint64()).Bytes(), nil
+}
+
+func PrecompileBlockHashHistory(_ keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileBlockHashHistory{}
+}
+
+// precompileBlockHashHistory: Precompile that reads the block hash ring buffer
+// of the EVM module, served at the address of the EIP-2935 system contract.
+type precompileBlockHashHistory struct{}
@@ -0,0 +1,95 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+func TestBlockHashHistory(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	currentHeight := int64(20_000)
+	deps.Ctx = deps.Ctx.WithBlockHeight(currentHeight)
+
+	window := evm.BlockHashHistoryServeWindow
+	oldest := uint64(currentHeight) - window
+	hashOf := func(height uint64) gethcommon.Hash {
+		return crypto.Keccak256Hash(gethcommon.BigToHash(
+			new(big.Int).SetUint64(height),
+		).Bytes())
+	}
+	for _, height := range []uint64{oldest, uint64(currentHeight) - 1} {
+		deps.EvmKeeper.EvmState.SetBlockHash(deps.Ctx, height, hashOf(height))
+	}
+
+	blockNumberInput := func(height uint64) []byte {
+		return gethcommon.BigToHash(new(big.Int).SetUint64(height)).Bytes()
+	}
+
+	testCases := []struct {
+		name     string
+		input    []byte
+		wantHash gethcommon.Hash
+		wantErr  string
+	}{
+		{
+			name:     "parent block",
+			input:    blockNumberInput(uint64(currentHeight) - 1),
+			wantHash: hashOf(uint64(currentHeight) - 1),
+		},
+		{
+			name:     "oldest block in the serve window",
+			input:    blockNumberInput(oldest),
+			wantHash: hashOf(oldest),
+		},
+		{
+			name:    "block older than the serve window",
+			input:   blockNumberInput(oldest - 1),
+			wantErr: "execution reverted",
+		},
+		{
+			name:    "current block",
+			input:   blockNumberInput(uint64(currentHeight)),
+			wantErr: "execution reverted",
+		},
+		{
+			name:    "future block",
+			input:   blockNumberInput(uint64(currentHeight) + 1),
+			wantErr: "execution reverted",
+		},
+		{
+			name:    "wrong input length",
+			input:   []byte{1},
+			wantErr: "execution reverted",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmObj, _ := deps.NewEVM()
+			evmResp, err := deps.EvmKeeper.CallContractWithInput(
+				deps.Ctx,
+				evmObj,
+				deps.Sender.EthAddr,
+				&precompile.PrecompileAddr_BlockHashHistory,
+				false,
+				tc.input,
+				100_000,
+			)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantHash, gethcommon.BytesToHash(evmResp.Ret))
+			require.GreaterOrEqual(t, evmResp.GasUsed, precompile.BlockHashHistoryGas)
+		})
+	}
+}
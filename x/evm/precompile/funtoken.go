@@ -6,6 +6,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app/keepers"
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
@@ -50,6 +52,7 @@ const (
 	FunTokenMethod_sendToEvm       PrecompileMethod = "sendToEvm"
 	FunTokenMethod_bankMsgSend     PrecompileMethod = "bankMsgSend"
 	FunTokenMethod_getErc20Address PrecompileMethod = "getErc20Address"
+	FunTokenMethod_listFunTokens   PrecompileMethod = "listFunTokens"
 )
 
 // Run runs the precompiled contract
@@ -93,6 +96,8 @@ func (p precompileFunToken) Run(
 		bz, err = p.bankMsgSend(startResult, trueCaller, readonly)
 	case FunTokenMethod_getErc20Address:
 		bz, err = p.getErc20Address(startResult, contract)
+	case FunTokenMethod_listFunTokens:
+		bz, err = p.listFunTokens(startResult, contract)
 	default:
 		// Note that this code path should be impossible to reach since
 		// "[decomposeInput]" parses methods directly from the ABI.
@@ -174,74 +179,21 @@ func (p precompileFunToken) sendToBank(
 	}
 	funtoken := funtokens[0]
 
-	// Amount should be positive
-	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
-		return nil, fmt.Errorf("transfer amount must be positive")
-	}
-
 	// The "to" argument must be a valid nibi or EVM address
 	toAddr, err := parseToAddr(to)
 	if err != nil {
 		return nil, fmt.Errorf("recipient address invalid (%s): %w", to, err)
 	}
 
-	// Caller transfers ERC20 to the EVM module account
-	gotAmount, _, err := p.evmKeeper.ERC20().Transfer(
-		erc20,                  /*erc20*/
-		caller,                 /*from*/
-		evm.EVM_MODULE_ADDRESS, /*to*/
-		amount,                 /*value*/
-		ctx,
-		evmObj,
+	// Caller transfers ERC20 to the EVM module account, which burns or
+	// escrows them and sends the corresponding Bank Coins.
+	bankCoin, err := p.evmKeeper.SendERC20ToBank(
+		ctx, evmObj, funtoken, caller, amount, eth.EthAddrToNibiruAddr(toAddr),
 	)
 	if err != nil {
-		return nil, fmt.Errorf(
-			"error in ERC20.transfer from caller to EVM account: %w: from %s, erc20 %s, amount: %s",
-			err, caller, erc20, amount,
-		)
-	}
-
-	// EVM account mints FunToken.BankDenom to module account
-	coinToSend := sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(gotAmount))
-	if funtoken.IsMadeFromCoin {
-		// If the FunToken mapping was created from a bank coin, then the EVM account
-		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
-		// Since we're sending them away and want accurate total supply tracking, the
-		// tokens need to be burned.
-		_, err := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
-		if err != nil {
-			return nil, fmt.Errorf("ERC20.Burn: %w", err)
-		}
-	} else {
-		// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
-		// any operation that has the potential to use Bank send methods. This will
-		// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
-		// recorded if wei (NIBI) is transferred.
-		err = p.evmKeeper.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
-		if err != nil {
-			return nil, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
-				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
-			)
-		}
-	}
-
-	// Transfer the bank coin
-	//
-	// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
-	// any operation that has the potential to use Bank send methods. This will
-	// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
-	// recorded if wei (NIBI) is transferred.
-	err = p.evmKeeper.Bank.SendCoinsFromModuleToAccount(
-		ctx,
-		evm.ModuleName,
-		eth.EthAddrToNibiruAddr(toAddr),
-		sdk.NewCoins(coinToSend),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
-			evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
-		)
+		return nil, err
 	}
+	gotAmount := bankCoin.Amount.BigInt()
 
 	return method.Outputs.Pack(gotAmount)
 }
@@ -824,6 +776,90 @@ func (p precompileFunToken) parseArgsGetErc20Address(args []any) (
 	return bankDenom, nil
 }
 
+// listFunTokens implements "IFunToken.listFunTokens". It returns a page of
+// the FunToken mappings using the "FunTokenMappings" query of the EVM module.
+//
+//	```solidity
+//	function listFunTokens(
+//	    uint256 offset,
+//	    uint256 limit
+//	) external view returns (FunToken[] memory funTokens, uint256 total);
+//	```
+func (p precompileFunToken) listFunTokens(
+	start OnRunStartResult,
+	contract *vm.Contract, // Needed for assertContractQuery
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.CacheCtx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	offset, limit, err := parseArgsListFunTokens(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	resp, err := p.evmKeeper.FunTokenMappings(
+		sdk.WrapSDKContext(ctx),
+		&evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Offset: offset, Limit: limit},
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	type solFunToken struct {
+		Erc20     gethcommon.Address `json:"erc20"`
+		BankDenom string             `json:"bankDenom"`
+	}
+	funTokens := make([]solFunToken, len(resp.FunTokens))
+	for i, funToken := range resp.FunTokens {
+		funTokens[i] = solFunToken{
+			Erc20:     funToken.Erc20Addr.Address,
+			BankDenom: funToken.BankDenom,
+		}
+	}
+	return method.Outputs.Pack(funTokens, new(big.Int).SetUint64(resp.Count))
+}
+
+// parseArgsListFunTokens parses the arguments for the listFunTokens method.
+// Expected arguments: (uint256 offset, uint256 limit)
+func parseArgsListFunTokens(args []any) (offset, limit uint64, err error) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	offsetArg, ok := args[0].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 offset", args[0])
+		return
+	}
+	limitArg, ok := args[1].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 limit", args[1])
+		return
+	}
+	if !offsetArg.IsUint64() {
+		err = fmt.Errorf("offset is too large: %s", offsetArg)
+		return
+	}
+
+	// Limits above the maximum page size use the maximum page size.
+	limit = common.DefaultPageItemsLimit
+	if limitArg.IsUint64() && limitArg.Uint64() > 0 {
+		limit = min(limitArg.Uint64(), common.DefaultPageItemsLimit)
+	}
+	return offsetArg.Uint64(), limit, nil
+}
+
 func parseArgsBankMsgSend(args []any) (toStr, denom string, amount *big.Int, err error) {
 	if e := assertNumArgs(args, 3); e != nil {
 		err = e
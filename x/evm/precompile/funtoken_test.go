@@ -1,6 +1,7 @@
 package precompile_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
@@ -711,6 +712,77 @@ func (out FunTokenBankBalanceReturn) ParseFromResp(
 	return out.BankBal, out.NibiruAcc.EthAddr, out.NibiruAcc.Bech32Addr, nil
 }
 
+func (s *FuntokenSuite) TestListFunTokens() {
+	deps := evmtest.NewTestDeps()
+
+	s.T().Log("Setup: Insert FunToken mappings")
+	for i := range 3 {
+		erc20 := gethcommon.BigToAddress(big.NewInt(int64(100 + i)))
+		s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsert(
+			deps.Ctx, erc20, "erc20/"+erc20.Hex(), false,
+		))
+	}
+
+	type solFunToken struct {
+		Erc20     gethcommon.Address `json:"erc20"`
+		BankDenom string             `json:"bankDenom"`
+	}
+	listFunTokens := func(offset, limit int64) (funTokens []solFunToken, total *big.Int) {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_listFunTokens),
+			big.NewInt(offset),
+			big.NewInt(limit),
+		)
+		s.Require().NoError(err)
+
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx,
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_FunToken,
+			false, // Commit = false
+			contractInput,
+			evmtest.FunTokenGasLimitSendToEvm,
+		)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_FunToken.ABI.Unpack(
+			string(precompile.FunTokenMethod_listFunTokens), resp.Ret,
+		)
+		s.Require().NoError(err)
+		s.Require().Len(out, 2)
+		bz, err := json.Marshal(out[0])
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(bz, &funTokens))
+		return funTokens, out[1].(*big.Int)
+	}
+
+	s.Run("first page", func() {
+		funTokens, total := listFunTokens(0, 2)
+		s.Len(funTokens, 2)
+		s.Equal("3", total.String())
+	})
+
+	s.Run("last page", func() {
+		funTokens, total := listFunTokens(2, 2)
+		s.Require().Len(funTokens, 1)
+		s.Equal("3", total.String())
+
+		want, err := deps.EvmKeeper.FunTokenMappings(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryFunTokenMappingsRequest{},
+		)
+		s.Require().NoError(err)
+		s.Equal(want.FunTokens[2].Erc20Addr.Address, funTokens[0].Erc20)
+		s.Equal(want.FunTokens[2].BankDenom, funTokens[0].BankDenom)
+	})
+
+	s.Run("zero limit uses the default page size", func() {
+		funTokens, _ := listFunTokens(0, 0)
+		s.Len(funTokens, 3)
+	})
+}
+
 func (s *FuntokenSuite) TestGetErc20Address() {
 	deps := evmtest.NewTestDeps()
 	bankDenom := "unibi" // Example bank denom
@@ -0,0 +1,528 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+var _ vm.PrecompiledContract = (*precompileGovernance)(nil)
+
+// Precompile address for "IGovernance.sol", the contract that enables votes,
+// deposits, and proposal queries of the "x/gov" module from the EVM.
+var PrecompileAddr_Governance = gethcommon.HexToAddress("0x0000000000000000000000000000000000000805")
+
+func (p precompileGovernance) Address() gethcommon.Address {
+	return PrecompileAddr_Governance
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileGovernance) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileGovernance) ABI() *gethabi.ABI {
+	return embeds.SmartContract_Governance.ABI
+}
+
+const (
+	GovMethod_vote         PrecompileMethod = "vote"
+	GovMethod_voteWeighted PrecompileMethod = "voteWeighted"
+	GovMethod_deposit      PrecompileMethod = "deposit"
+	GovMethod_getProposal  PrecompileMethod = "getProposal"
+	GovMethod_getTally     PrecompileMethod = "getTally"
+	GovMethod_getVote      PrecompileMethod = "getVote"
+)
+
+// Run runs the precompiled contract
+func (p precompileGovernance) Run(
+	evm *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	defer func() {
+		err = ErrPrecompileRun(err, p)
+	}()
+	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gracefully handles "out of gas"
+	defer HandleOutOfGasPanic(&err)()
+
+	abciEventsStartIdx := len(startResult.CacheCtx.EventManager().Events())
+
+	method := startResult.Method
+	switch PrecompileMethod(method.Name) {
+	case GovMethod_vote:
+		bz, err = p.vote(startResult, trueCaller, readonly)
+	case GovMethod_voteWeighted:
+		bz, err = p.voteWeighted(startResult, trueCaller, readonly)
+	case GovMethod_deposit:
+		bz, err = p.deposit(startResult, trueCaller, readonly)
+	case GovMethod_getProposal:
+		bz, err = p.getProposal(startResult, contract)
+	case GovMethod_getTally:
+		bz, err = p.getTally(startResult, contract)
+	case GovMethod_getVote:
+		bz, err = p.getVote(startResult, contract)
+	default:
+		// Note that this code path should be impossible to reach since
+		// "[decomposeInput]" parses methods directly from the ABI.
+		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+		return
+	}
+	// Gas consumed by a local gas meter
+	contract.UseGas(
+		startResult.CacheCtx.GasMeter().GasConsumed(),
+		evm.Config.Tracer,
+		tracing.GasChangeCallPrecompiledContract,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit extra events for the EVM if this is a transaction
+	// https://github.com/NibiruChain/nibiru/issues/2121
+	if isMutation[PrecompileMethod(startResult.Method.Name)] {
+		EmitEventAbciEvents(
+			startResult.CacheCtx,
+			startResult.StateDB,
+			startResult.CacheCtx.EventManager().Events()[abciEventsStartIdx:],
+			p.Address(),
+		)
+	}
+
+	return bz, err
+}
+
+func PrecompileGovernance(keepers keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileGovernance{
+		govKeeper: keepers.GovKeeper,
+	}
+}
+
+type precompileGovernance struct {
+	govKeeper *govkeeper.Keeper
+}
+
+// vote: Implements "IGovernance.vote"
+//
+//	```solidity
+//	function vote(
+//	    uint64 proposalId,
+//	    uint8 option,
+//	    string memory metadata
+//	) external returns (bool success);
+//	```
+func (p precompileGovernance) vote(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	proposalId, option, metadata, err := p.parseArgsVote(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := gov.NewMsgVote(eth.EthAddrToNibiruAddr(caller), proposalId, option, metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := govkeeper.NewMsgServerImpl(p.govKeeper).Vote(
+		sdk.WrapSDKContext(ctx), msg,
+	); err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	return method.Outputs.Pack(true)
+}
+
+func (p precompileGovernance) parseArgsVote(args []any) (
+	proposalId uint64,
+	option gov.VoteOption,
+	metadata string,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	proposalId, ok := args[argIdx].(uint64)
+	if !ok {
+		err = ErrArgTypeValidation("uint64 proposalId", args[argIdx])
+		return
+	}
+
+	argIdx++
+	optionNum, ok := args[argIdx].(uint8)
+	if !ok {
+		err = ErrArgTypeValidation("uint8 option", args[argIdx])
+		return
+	}
+	option = gov.VoteOption(optionNum)
+
+	argIdx++
+	metadata, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string metadata", args[argIdx])
+		return
+	}
+	return
+}
+
+// voteWeighted: Implements "IGovernance.voteWeighted"
+//
+//	```solidity
+//	function voteWeighted(
+//	    uint64 proposalId,
+//	    WeightedVoteOption[] memory options,
+//	    string memory metadata
+//	) external returns (bool success);
+//	```
+func (p precompileGovernance) voteWeighted(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	proposalId, options, metadata, err := p.parseArgsVoteWeighted(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := gov.NewMsgVoteWeighted(eth.EthAddrToNibiruAddr(caller), proposalId, options, metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := govkeeper.NewMsgServerImpl(p.govKeeper).VoteWeighted(
+		sdk.WrapSDKContext(ctx), msg,
+	); err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	return method.Outputs.Pack(true)
+}
+
+func (p precompileGovernance) parseArgsVoteWeighted(args []any) (
+	proposalId uint64,
+	options gov.WeightedVoteOptions,
+	metadata string,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	proposalId, ok := args[argIdx].(uint64)
+	if !ok {
+		err = ErrArgTypeValidation("uint64 proposalId", args[argIdx])
+		return
+	}
+
+	argIdx++
+	rawOptions, ok := args[argIdx].([]struct {
+		Option uint8    `json:"option"`
+		Weight *big.Int `json:"weight"`
+	})
+	if !ok {
+		err = ErrArgTypeValidation("WeightedVoteOption[] options", args[argIdx])
+		return
+	}
+	for _, opt := range rawOptions {
+		options = append(options, gov.NewWeightedVoteOption(
+			gov.VoteOption(opt.Option),
+			sdk.NewDecFromBigIntWithPrec(opt.Weight, sdk.Precision),
+		))
+	}
+
+	argIdx++
+	metadata, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string metadata", args[argIdx])
+		return
+	}
+	return
+}
+
+// deposit: Implements "IGovernance.deposit"
+//
+//	```solidity
+//	function deposit(
+//	    uint64 proposalId,
+//	    INibiruEvm.BankCoin[] memory amount
+//	) external returns (bool success);
+//	```
+//
+// The coins come from the bank balance of the caller, so the EVM balance of
+// the caller decreases accordingly for deposits of "unibi".
+func (p precompileGovernance) deposit(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	if err := assertNumArgs(args, 2); err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+	proposalId, ok := args[0].(uint64)
+	if !ok {
+		return nil, ErrInvalidArgs(ErrArgTypeValidation("uint64 proposalId", args[0]))
+	}
+	amount, err := parseFundsArg(args[1])
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := gov.NewMsgDeposit(eth.EthAddrToNibiruAddr(caller), proposalId, amount.Sort())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := govkeeper.NewMsgServerImpl(p.govKeeper).Deposit(
+		sdk.WrapSDKContext(ctx), msg,
+	); err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	return method.Outputs.Pack(true)
+}
+
+// getProposal: Implements "IGovernance.getProposal"
+//
+//	```solidity
+//	function getProposal(
+//	    uint64 proposalId
+//	) external view returns (Proposal memory);
+//	```
+func (p precompileGovernance) getProposal(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	proposal, err := p.parseArgsProposal(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	type BankCoin struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	}
+	totalDeposit := make([]BankCoin, len(proposal.TotalDeposit))
+	for i, coin := range proposal.TotalDeposit {
+		totalDeposit[i] = BankCoin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	unixOrZero := func(t *time.Time) int64 {
+		if t == nil {
+			return 0
+		}
+		return t.Unix()
+	}
+	return method.Outputs.Pack(struct {
+		Id              uint64     `json:"id"`
+		Status          uint8      `json:"status"`
+		Proposer        string     `json:"proposer"`
+		Title           string     `json:"title"`
+		Summary         string     `json:"summary"`
+		Metadata        string     `json:"metadata"`
+		TotalDeposit    []BankCoin `json:"totalDeposit"`
+		SubmitTime      int64      `json:"submitTime"`
+		DepositEndTime  int64      `json:"depositEndTime"`
+		VotingStartTime int64      `json:"votingStartTime"`
+		VotingEndTime   int64      `json:"votingEndTime"`
+	}{
+		Id:              proposal.Id,
+		Status:          uint8(proposal.Status),
+		Proposer:        proposal.Proposer,
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		Metadata:        proposal.Metadata,
+		TotalDeposit:    totalDeposit,
+		SubmitTime:      unixOrZero(proposal.SubmitTime),
+		DepositEndTime:  unixOrZero(proposal.DepositEndTime),
+		VotingStartTime: unixOrZero(proposal.VotingStartTime),
+		VotingEndTime:   unixOrZero(proposal.VotingEndTime),
+	})
+}
+
+// getTally: Implements "IGovernance.getTally"
+//
+//	```solidity
+//	function getTally(
+//	    uint64 proposalId
+//	) external view returns (TallyResult memory);
+//	```
+//
+// Mirrors the "TallyResult" query of the "x/gov" module.
+func (p precompileGovernance) getTally(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	proposal, err := p.parseArgsProposal(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var tally gov.TallyResult
+	switch proposal.Status {
+	case gov.StatusDepositPeriod:
+		tally = gov.EmptyTallyResult()
+	case gov.StatusPassed, gov.StatusRejected, gov.StatusFailed:
+		tally = *proposal.FinalTallyResult
+	default:
+		// Tallying deletes the votes of the proposal, so it runs on a context
+		// that is thrown away.
+		tallyCtx, _ := ctx.CacheContext()
+		_, _, tally = p.govKeeper.Tally(tallyCtx, proposal)
+	}
+
+	type TallyResult struct {
+		Yes        *big.Int `json:"yes"`
+		Abstain    *big.Int `json:"abstain"`
+		No         *big.Int `json:"no"`
+		NoWithVeto *big.Int `json:"noWithVeto"`
+	}
+	var out TallyResult
+	for _, count := range []struct {
+		dest **big.Int
+		src  string
+	}{
+		{&out.Yes, tally.YesCount},
+		{&out.Abstain, tally.AbstainCount},
+		{&out.No, tally.NoCount},
+		{&out.NoWithVeto, tally.NoWithVetoCount},
+	} {
+		amount, ok := new(big.Int).SetString(count.src, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid tally count \"%s\"", count.src)
+		}
+		*count.dest = amount
+	}
+	return method.Outputs.Pack(out)
+}
+
+// getVote: Implements "IGovernance.getVote"
+//
+//	```solidity
+//	function getVote(
+//	    uint64 proposalId,
+//	    address voter
+//	) external view returns (WeightedVoteOption[] memory options);
+//	```
+func (p precompileGovernance) getVote(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 2); e != nil {
+		return nil, ErrInvalidArgs(e)
+	}
+	proposalId, ok := args[0].(uint64)
+	if !ok {
+		return nil, ErrInvalidArgs(ErrArgTypeValidation("uint64 proposalId", args[0]))
+	}
+	voter, ok := args[1].(gethcommon.Address)
+	if !ok {
+		return nil, ErrInvalidArgs(ErrArgTypeValidation("address voter", args[1]))
+	}
+
+	type WeightedVoteOption struct {
+		Option uint8    `json:"option"`
+		Weight *big.Int `json:"weight"`
+	}
+	options := []WeightedVoteOption{}
+	vote, found := p.govKeeper.GetVote(ctx, proposalId, eth.EthAddrToNibiruAddr(voter))
+	if found {
+		for _, opt := range vote.Options {
+			weight, err := sdk.NewDecFromStr(opt.Weight)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, WeightedVoteOption{
+				Option: uint8(opt.Option),
+				Weight: weight.BigInt(),
+			})
+		}
+	}
+	return method.Outputs.Pack(options)
+}
+
+// parseArgsProposal parses the "uint64 proposalId" argument of a query and
+// returns the proposal with that ID.
+func (p precompileGovernance) parseArgsProposal(
+	ctx sdk.Context, args []any,
+) (proposal gov.Proposal, err error) {
+	if e := assertNumArgs(args, 1); e != nil {
+		return proposal, ErrInvalidArgs(e)
+	}
+	proposalId, ok := args[0].(uint64)
+	if !ok {
+		return proposal, ErrInvalidArgs(ErrArgTypeValidation("uint64 proposalId", args[0]))
+	}
+	proposal, found := p.govKeeper.GetProposal(ctx, proposalId)
+	if !found {
+		return proposal, fmt.Errorf("proposal %d does not exist", proposalId)
+	}
+	return proposal, nil
+}
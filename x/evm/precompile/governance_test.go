@@ -0,0 +1,237 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+type GovernanceSuite struct {
+	suite.Suite
+}
+
+func TestGovernanceSuite(t *testing.T) {
+	suite.Run(t, new(GovernanceSuite))
+}
+
+func (s *GovernanceSuite) callGov(
+	deps *evmtest.TestDeps, commit bool, method precompile.PrecompileMethod, args ...any,
+) (*evm.MsgEthereumTxResponse, error) {
+	input, err := embeds.SmartContract_Governance.ABI.Pack(string(method), args...)
+	s.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	return deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx,
+		evmObj,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Governance,
+		commit,
+		input,
+		keeper.Erc20GasLimitExecute,
+	)
+}
+
+// setupProposal funds and delegates for the sender so that its votes have
+// voting power, and submits a proposal in the voting period.
+func (s *GovernanceSuite) setupProposal(deps *evmtest.TestDeps) gov.Proposal {
+	bondDenom := deps.App.StakingKeeper.BondDenom(deps.Ctx)
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(10_000_000))),
+	))
+	val := deps.App.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	_, err := deps.App.StakingKeeper.Delegate(
+		deps.Ctx, deps.Sender.NibiruAddr, sdk.NewInt(4_000_000), stakingtypes.Unbonded, val, true,
+	)
+	s.Require().NoError(err)
+
+	proposal, err := deps.App.GovKeeper.SubmitProposal(
+		deps.Ctx, nil, "", "title", "summary", deps.Sender.NibiruAddr,
+	)
+	s.Require().NoError(err)
+	deps.App.GovKeeper.ActivateVotingPeriod(deps.Ctx, proposal)
+	proposal, _ = deps.App.GovKeeper.GetProposal(deps.Ctx, proposal.Id)
+	return proposal
+}
+
+type weightedVoteOption = struct {
+	Option uint8    `json:"option"`
+	Weight *big.Int `json:"weight"`
+}
+
+func (s *GovernanceSuite) TestHappyPath() {
+	deps := evmtest.NewTestDeps()
+	proposal := s.setupProposal(&deps)
+	bondDenom := deps.App.StakingKeeper.BondDenom(deps.Ctx)
+
+	s.Run("IGovernance.getProposal", func() {
+		evmResp, err := s.callGov(&deps, false, precompile.GovMethod_getProposal, proposal.Id)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_Governance.ABI.Unpack(
+			string(precompile.GovMethod_getProposal), evmResp.Ret,
+		)
+		s.Require().NoError(err)
+		gotProposal := out[0].(struct {
+			Id           uint64 `json:"id"`
+			Status       uint8  `json:"status"`
+			Proposer     string `json:"proposer"`
+			Title        string `json:"title"`
+			Summary      string `json:"summary"`
+			Metadata     string `json:"metadata"`
+			TotalDeposit []struct {
+				Denom  string   `json:"denom"`
+				Amount *big.Int `json:"amount"`
+			} `json:"totalDeposit"`
+			SubmitTime      int64 `json:"submitTime"`
+			DepositEndTime  int64 `json:"depositEndTime"`
+			VotingStartTime int64 `json:"votingStartTime"`
+			VotingEndTime   int64 `json:"votingEndTime"`
+		})
+		s.Equal(proposal.Id, gotProposal.Id)
+		s.Equal(uint8(gov.StatusVotingPeriod), gotProposal.Status)
+		s.Equal(deps.Sender.NibiruAddr.String(), gotProposal.Proposer)
+		s.Equal("title", gotProposal.Title)
+		s.Equal(proposal.VotingEndTime.Unix(), gotProposal.VotingEndTime)
+	})
+
+	s.Run("IGovernance.vote", func() {
+		evmResp, err := s.callGov(
+			&deps, true, precompile.GovMethod_vote, proposal.Id, uint8(gov.OptionYes), "",
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(evmResp.VmError)
+
+		vote, found := deps.App.GovKeeper.GetVote(deps.Ctx, proposal.Id, deps.Sender.NibiruAddr)
+		s.Require().True(found)
+		s.Require().Len(vote.Options, 1)
+		s.Equal(gov.OptionYes, vote.Options[0].Option)
+
+		s.T().Log("Expect ABCI events of the vote in the EVM logs")
+		s.NotEmpty(evmResp.Logs)
+		for _, log := range evmResp.Logs {
+			s.Equal(precompile.PrecompileAddr_Governance.Hex(), log.Address)
+		}
+	})
+
+	s.Run("IGovernance.getTally", func() {
+		evmResp, err := s.callGov(&deps, false, precompile.GovMethod_getTally, proposal.Id)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_Governance.ABI.Unpack(
+			string(precompile.GovMethod_getTally), evmResp.Ret,
+		)
+		s.Require().NoError(err)
+		tally := out[0].(struct {
+			Yes        *big.Int `json:"yes"`
+			Abstain    *big.Int `json:"abstain"`
+			No         *big.Int `json:"no"`
+			NoWithVeto *big.Int `json:"noWithVeto"`
+		})
+		s.Equal(big.NewInt(4_000_000), tally.Yes)
+		s.Zero(tally.No.Sign())
+
+		s.T().Log("Expect the tally to keep the votes")
+		_, found := deps.App.GovKeeper.GetVote(deps.Ctx, proposal.Id, deps.Sender.NibiruAddr)
+		s.True(found)
+	})
+
+	s.Run("IGovernance.voteWeighted", func() {
+		halfWeight := sdk.NewDecWithPrec(5, 1).BigInt()
+		evmResp, err := s.callGov(
+			&deps, true, precompile.GovMethod_voteWeighted, proposal.Id,
+			[]weightedVoteOption{
+				{Option: uint8(gov.OptionYes), Weight: halfWeight},
+				{Option: uint8(gov.OptionNo), Weight: halfWeight},
+			},
+			"split",
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(evmResp.VmError)
+	})
+
+	s.Run("IGovernance.getVote", func() {
+		evmResp, err := s.callGov(
+			&deps, false, precompile.GovMethod_getVote, proposal.Id, deps.Sender.EthAddr,
+		)
+		s.Require().NoError(err)
+
+		var options []weightedVoteOption
+		s.Require().NoError(embeds.SmartContract_Governance.ABI.UnpackIntoInterface(
+			&options, string(precompile.GovMethod_getVote), evmResp.Ret,
+		))
+		s.Require().Len(options, 2)
+		s.Equal(uint8(gov.OptionYes), options[0].Option)
+		s.Equal(sdk.NewDecWithPrec(5, 1).BigInt(), options[0].Weight)
+	})
+
+	s.Run("IGovernance.deposit", func() {
+		evmResp, err := s.callGov(
+			&deps, true, precompile.GovMethod_deposit, proposal.Id,
+			[]precompile.WasmBankCoin{{Denom: bondDenom, Amount: big.NewInt(1_000)}},
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(evmResp.VmError)
+
+		deposit, found := deps.App.GovKeeper.GetDeposit(deps.Ctx, proposal.Id, deps.Sender.NibiruAddr)
+		s.Require().True(found)
+		s.Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000)), sdk.NewCoins(deposit.Amount...))
+	})
+}
+
+func (s *GovernanceSuite) TestFailures() {
+	deps := evmtest.NewTestDeps()
+	proposal := s.setupProposal(&deps)
+
+	s.Run("invalid vote option", func() {
+		_, err := s.callGov(&deps, true, precompile.GovMethod_vote, proposal.Id, uint8(42), "")
+		s.Require().ErrorContains(err, "invalid vote option")
+	})
+
+	s.Run("weights that do not add up to 1", func() {
+		_, err := s.callGov(
+			&deps, true, precompile.GovMethod_voteWeighted, proposal.Id,
+			[]weightedVoteOption{
+				{Option: uint8(gov.OptionYes), Weight: sdk.NewDecWithPrec(5, 1).BigInt()},
+			},
+			"",
+		)
+		s.Require().Error(err)
+	})
+
+	s.Run("vote on a proposal that does not exist", func() {
+		_, err := s.callGov(&deps, true, precompile.GovMethod_vote, uint64(1_000), uint8(gov.OptionYes), "")
+		s.Require().ErrorContains(err, "1000")
+	})
+
+	s.Run("query a proposal that does not exist", func() {
+		_, err := s.callGov(&deps, false, precompile.GovMethod_getProposal, uint64(1_000))
+		s.Require().ErrorContains(err, "does not exist")
+	})
+
+	s.Run("vote of an account that has not voted is empty", func() {
+		evmResp, err := s.callGov(
+			&deps, false, precompile.GovMethod_getVote, proposal.Id, evmtest.NewEthPrivAcc().EthAddr,
+		)
+		s.Require().NoError(err)
+
+		var options []weightedVoteOption
+		s.Require().NoError(embeds.SmartContract_Governance.ABI.UnpackIntoInterface(
+			&options, string(precompile.GovMethod_getVote), evmResp.Ret,
+		))
+		s.Empty(options)
+	})
+}
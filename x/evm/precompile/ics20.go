@@ -0,0 +1,267 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ vm.PrecompiledContract = (*precompileICS20)(nil)
+
+// Precompile address for "IICS20.sol", the contract that enables ICS-20
+// transfers of bank coins and FunToken ERC20s over IBC from the EVM.
+var PrecompileAddr_ICS20 = gethcommon.HexToAddress("0x0000000000000000000000000000000000000804")
+
+func (p precompileICS20) Address() gethcommon.Address {
+	return PrecompileAddr_ICS20
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileICS20) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileICS20) ABI() *gethabi.ABI {
+	return embeds.SmartContract_ICS20.ABI
+}
+
+const (
+	ICS20Method_transfer PrecompileMethod = "transfer"
+)
+
+// Run runs the precompiled contract
+func (p precompileICS20) Run(
+	evm *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	defer func() {
+		err = ErrPrecompileRun(err, p)
+	}()
+	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gracefully handles "out of gas"
+	defer HandleOutOfGasPanic(&err)()
+
+	abciEventsStartIdx := len(startResult.CacheCtx.EventManager().Events())
+
+	method := startResult.Method
+	switch PrecompileMethod(method.Name) {
+	case ICS20Method_transfer:
+		bz, err = p.transfer(startResult, trueCaller, readonly, evm)
+	default:
+		// Note that this code path should be impossible to reach since
+		// "[decomposeInput]" parses methods directly from the ABI.
+		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+		return
+	}
+	// Gas consumed by a local gas meter
+	contract.UseGas(
+		startResult.CacheCtx.GasMeter().GasConsumed(),
+		evm.Config.Tracer,
+		tracing.GasChangeCallPrecompiledContract,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit extra events for the EVM if this is a transaction
+	// https://github.com/NibiruChain/nibiru/issues/2121
+	if isMutation[PrecompileMethod(startResult.Method.Name)] {
+		EmitEventAbciEvents(
+			startResult.CacheCtx,
+			startResult.StateDB,
+			startResult.CacheCtx.EventManager().Events()[abciEventsStartIdx:],
+			p.Address(),
+		)
+	}
+
+	return bz, err
+}
+
+func PrecompileICS20(keepers keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileICS20{
+		evmKeeper:      keepers.EvmKeeper,
+		transferKeeper: keepers.IBCTransferKeeper,
+	}
+}
+
+type precompileICS20 struct {
+	evmKeeper      *evmkeeper.Keeper
+	transferKeeper ibctransferkeeper.Keeper
+}
+
+// transfer: Implements "IICS20.transfer"
+//
+//	```solidity
+//	function transfer(
+//	    string memory sourceChannel,
+//	    string memory sourcePort,
+//	    string memory denomOrErc20,
+//	    uint256 amount,
+//	    string memory receiver,
+//	    Height memory timeoutHeight,
+//	    uint64 timeoutTimestamp,
+//	    string memory memo
+//	) external returns (uint64 sequence);
+//	```
+//
+// If "denomOrErc20" is the address of a FunToken ERC20, the ERC20 tokens of
+// the caller are converted to bank coins of the caller in the same way as
+// "IFunToken.sendToBank", and the amount sent over IBC is the amount of coins
+// received from the conversion. Refunds of such transfers are converted back
+// to ERC20 tokens by the [evmkeeper.Keeper.OnIBCTransferPacketDone] hook.
+func (p precompileICS20) transfer(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+	evmObj *vm.EVM,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	msg, denomOrErc20, amount, err := p.parseArgsTransfer(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+	sender := eth.EthAddrToNibiruAddr(caller)
+	msg.Sender = sender.String()
+
+	isErc20 := gethcommon.IsHexAddress(denomOrErc20)
+	if isErc20 {
+		erc20 := gethcommon.HexToAddress(denomOrErc20)
+		funtokens := p.evmKeeper.FunTokens.Collect(
+			ctx, p.evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
+		)
+		if len(funtokens) != 1 {
+			return nil, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20.Hex())
+		}
+		msg.Token, err = p.evmKeeper.SendERC20ToBank(
+			ctx, evmObj, funtokens[0], caller, amount, sender,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		msg.Token = sdk.NewCoin(denomOrErc20, sdkmath.NewIntFromBigInt(amount))
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	resp, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	if isErc20 {
+		p.evmKeeper.SetIBCTransferRefund(
+			ctx, msg.SourcePort, msg.SourceChannel, resp.Sequence, caller,
+		)
+	}
+	return method.Outputs.Pack(resp.Sequence)
+}
+
+// parseArgsTransfer parses the arguments of "IICS20.transfer" into a
+// [transfertypes.MsgTransfer] without the sender and token.
+func (p precompileICS20) parseArgsTransfer(args []any) (
+	msg *transfertypes.MsgTransfer,
+	denomOrErc20 string,
+	amount *big.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 8); e != nil {
+		err = e
+		return
+	}
+	msg = new(transfertypes.MsgTransfer)
+
+	var ok bool
+	argIdx := 0
+	msg.SourceChannel, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string sourceChannel", args[argIdx])
+		return
+	}
+
+	argIdx++
+	msg.SourcePort, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string sourcePort", args[argIdx])
+		return
+	}
+
+	argIdx++
+	denomOrErc20, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string denomOrErc20", args[argIdx])
+		return
+	}
+
+	argIdx++
+	amount, ok = args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+
+	argIdx++
+	msg.Receiver, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string receiver", args[argIdx])
+		return
+	}
+
+	argIdx++
+	timeoutHeight, ok := args[argIdx].(struct {
+		RevisionNumber uint64 `json:"revisionNumber"`
+		RevisionHeight uint64 `json:"revisionHeight"`
+	})
+	if !ok {
+		err = ErrArgTypeValidation("Height timeoutHeight", args[argIdx])
+		return
+	}
+	msg.TimeoutHeight = clienttypes.NewHeight(
+		timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight,
+	)
+
+	argIdx++
+	msg.TimeoutTimestamp, ok = args[argIdx].(uint64)
+	if !ok {
+		err = ErrArgTypeValidation("uint64 timeoutTimestamp", args[argIdx])
+		return
+	}
+
+	argIdx++
+	msg.Memo, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string memo", args[argIdx])
+		return
+	}
+
+	return
+}
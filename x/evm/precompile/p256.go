@@ -0,0 +1,70 @@
+package precompile
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/secp256r1"
+)
+
+var _ vm.PrecompiledContract = (*precompileP256Verify)(nil)
+
+// PrecompileAddr_P256Verify is the address of the P-256 (secp256r1) signature
+// verification precompile, as defined by RIP-7212.
+var PrecompileAddr_P256Verify = gethcommon.HexToAddress("0x0000000000000000000000000000000000000100")
+
+const (
+	// P256VerifyGas is the fixed gas cost of a call to the P-256 verification
+	// precompile, as defined by RIP-7212.
+	P256VerifyGas uint64 = 3450
+
+	// p256VerifyInputLength: Byte length of the input, (hash, r, s, x, y),
+	// with each value encoded as 32 bytes.
+	p256VerifyInputLength = 160
+)
+
+func (p precompileP256Verify) Address() gethcommon.Address {
+	return PrecompileAddr_P256Verify
+}
+
+func (p precompileP256Verify) RequiredGas(_ []byte) uint64 {
+	return P256VerifyGas
+}
+
+// Run verifies a P-256 (secp256r1) signature following RIP-7212, which lets
+// smart accounts check passkey (WebAuthn) signatures cheaply.
+//
+// The input is 160 bytes: the message hash, the signature values "r" and "s",
+// and the public key coordinates "x" and "y", each encoded as 32 bytes. If
+// the signature is valid, the output is 1 encoded as 32 bytes. Otherwise, the
+// output is empty, including for malformed input. The call never reverts.
+func (p precompileP256Verify) Run(
+	evm *vm.EVM,
+	trueCaller gethcommon.Address,
+	contract *vm.Contract,
+	readonly bool,
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	input := contract.Input
+	if len(input) != p256VerifyInputLength {
+		return nil, nil
+	}
+
+	hash := input[0:32]
+	r, s := new(big.Int).SetBytes(input[32:64]), new(big.Int).SetBytes(input[64:96])
+	x, y := new(big.Int).SetBytes(input[96:128]), new(big.Int).SetBytes(input[128:160])
+	if !secp256r1.Verify(hash, r, s, x, y) {
+		return nil, nil
+	}
+	return gethcommon.LeftPadBytes([]byte{1}, 32), nil
+}
+
+func PrecompileP256Verify(_ keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileP256Verify{}
+}
+
+// precompileP256Verify: Stateless precompile for P-256 signature verification.
+type precompileP256Verify struct{}
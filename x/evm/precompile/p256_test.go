@@ -0,0 +1,80 @@
+package precompile_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+func TestP256Verify(t *testing.T) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("nibiru passkey"))
+	r, s, err := ecdsa.Sign(rand.Reader, privKey, hash[:])
+	require.NoError(t, err)
+
+	// input: hash | r | s | x | y
+	validInput := append([]byte{}, hash[:]...)
+	for _, v := range [][]byte{
+		r.Bytes(), s.Bytes(), privKey.PublicKey.X.Bytes(), privKey.PublicKey.Y.Bytes(),
+	} {
+		validInput = append(validInput, gethcommon.LeftPadBytes(v, 32)...)
+	}
+
+	tamperedInput := append([]byte{}, validInput...)
+	tamperedInput[0] ^= 0xff
+
+	testCases := []struct {
+		name    string
+		input   []byte
+		wantRet []byte
+	}{
+		{
+			name:    "valid signature",
+			input:   validInput,
+			wantRet: gethcommon.LeftPadBytes([]byte{1}, 32),
+		},
+		{
+			name:    "invalid signature",
+			input:   tamperedInput,
+			wantRet: []byte{},
+		},
+		{
+			name:    "wrong input length",
+			input:   validInput[:159],
+			wantRet: []byte{},
+		},
+		{
+			name:    "empty input",
+			input:   []byte{},
+			wantRet: []byte{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := evmtest.NewTestDeps()
+			evmObj, _ := deps.NewEVM()
+			evmResp, err := deps.EvmKeeper.CallContractWithInput(
+				deps.Ctx,
+				evmObj,
+				deps.Sender.EthAddr,
+				&precompile.PrecompileAddr_P256Verify,
+				false,
+				tc.input,
+				100_000,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRet, append([]byte{}, evmResp.Ret...))
+			require.GreaterOrEqual(t, evmResp.GasUsed, precompile.P256VerifyGas)
+		})
+	}
+}
@@ -8,6 +8,10 @@
 // Key components:
 //   - InitPrecompiles: Initializes and returns a map of precompiled contracts.
 //   - PrecompileFunToken: Implements the FunToken precompile for ERC20-to-bank transfers.
+//   - PrecompileStaking: Implements the Staking precompile for delegations and rewards.
+//   - PrecompileICS20: Implements the ICS20 precompile for IBC transfers.
+//   - PrecompileGovernance: Implements the Governance precompile for votes and deposits.
+//   - PrecompileBlockHashHistory: Serves recent block hashes at the EIP-2935 address.
 //
 // The package also provides utility functions for working with precompiles, such
 // as "ABIMethodByID" and "OnRunStart" for common precompile execution setup.
@@ -26,6 +30,8 @@ import (
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
 
@@ -45,28 +51,48 @@ func InitPrecompiles(
 		PrecompileFunToken,
 		PrecompileWasm,
 		PrecompileOracle,
+		PrecompileP256Verify,
+		PrecompileStaking,
+		PrecompileICS20,
+		PrecompileGovernance,
+		PrecompileBlockHashHistory,
 	} {
 		pc := precompileSetupFn(k)
+		// The EVM picks one of these maps from the rules of its chain config.
+		// Only the Cancun map has the KZG point evaluation precompile (0x0a),
+		// so it only runs if the "enable_cancun" parameter is on.
 		for _, precompileMap := range []map[gethcommon.Address]vm.PrecompiledContract{
 			vm.PrecompiledContractsHomestead,
 			vm.PrecompiledContractsByzantium,
 			vm.PrecompiledContractsIstanbul,
 			vm.PrecompiledContractsBerlin,
+			vm.PrecompiledContractsCancun,
 			// Below precompiles omitted intentionally.
-			// vm.PrecompiledContractsCancun,
 			// vm.PrecompiledContractsBLS,
 		} {
 			precompileMap[pc.Address()] = pc
 		}
 	}
+}
 
-	// TODO: feat(evm): implement precompiled contracts for ibc transfer
-	// Check if there is sufficient demand for this.
-
-	// TODO: feat(evm): implement precompiled contracts for staking
-	// Note that liquid staked assets can be a useful alternative to adding a
-	// staking precompile.
-	// Check if there is sufficient demand for this.
+func init() {
+	// Names and ABIs of the custom precompiles for the precompile tracer
+	for _, traced := range []struct {
+		addr gethcommon.Address
+		name string
+		abi  *gethabi.ABI
+	}{
+		{PrecompileAddr_FunToken, "FunToken", embeds.SmartContract_FunToken.ABI},
+		{PrecompileAddr_Oracle, "Oracle", embeds.SmartContract_Oracle.ABI},
+		{PrecompileAddr_Wasm, "Wasm", embeds.SmartContract_Wasm.ABI},
+		{PrecompileAddr_Staking, "Staking", embeds.SmartContract_Staking.ABI},
+		{PrecompileAddr_ICS20, "ICS20", embeds.SmartContract_ICS20.ABI},
+		{PrecompileAddr_Governance, "Governance", embeds.SmartContract_Governance.ABI},
+		{PrecompileAddr_P256Verify, "P256Verify", nil},
+		{PrecompileAddr_BlockHashHistory, "BlockHashHistory", nil},
+	} {
+		evm.RegisterTracedPrecompile(traced.addr, traced.name, traced.abi)
+	}
 }
 
 type NibiruCustomPrecompile interface {
@@ -233,11 +259,28 @@ var isMutation map[PrecompileMethod]bool = map[PrecompileMethod]bool{
 	FunTokenMethod_bankBalance:     false,
 	FunTokenMethod_whoAmI:          false,
 	FunTokenMethod_getErc20Address: false,
+	FunTokenMethod_listFunTokens:   false,
 
 	FunTokenMethod_sendToEvm:   true,
 	FunTokenMethod_bankMsgSend: true,
 
 	OracleMethod_queryExchangeRate: false,
+
+	StakingMethod_delegate:                 true,
+	StakingMethod_undelegate:               true,
+	StakingMethod_redelegate:               true,
+	StakingMethod_withdrawDelegatorRewards: true,
+	StakingMethod_delegation:               false,
+	StakingMethod_validator:                false,
+
+	ICS20Method_transfer: true,
+
+	GovMethod_vote:         true,
+	GovMethod_voteWeighted: true,
+	GovMethod_deposit:      true,
+	GovMethod_getProposal:  false,
+	GovMethod_getTally:     false,
+	GovMethod_getVote:      false,
 }
 
 func HandleOutOfGasPanic(err *error) func() {
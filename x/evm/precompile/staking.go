@@ -0,0 +1,474 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+var _ vm.PrecompiledContract = (*precompileStaking)(nil)
+
+// Precompile address for "IStaking.sol", the contract that enables
+// delegations to validators and withdrawals of staking rewards from the EVM.
+var PrecompileAddr_Staking = gethcommon.HexToAddress("0x0000000000000000000000000000000000000803")
+
+func (p precompileStaking) Address() gethcommon.Address {
+	return PrecompileAddr_Staking
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileStaking) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileStaking) ABI() *gethabi.ABI {
+	return embeds.SmartContract_Staking.ABI
+}
+
+const (
+	StakingMethod_delegate                 PrecompileMethod = "delegate"
+	StakingMethod_undelegate               PrecompileMethod = "undelegate"
+	StakingMethod_redelegate               PrecompileMethod = "redelegate"
+	StakingMethod_withdrawDelegatorRewards PrecompileMethod = "withdrawDelegatorRewards"
+	StakingMethod_delegation               PrecompileMethod = "delegation"
+	StakingMethod_validator                PrecompileMethod = "validator"
+)
+
+// Run runs the precompiled contract
+func (p precompileStaking) Run(
+	evm *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	defer func() {
+		err = ErrPrecompileRun(err, p)
+	}()
+	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gracefully handles "out of gas"
+	defer HandleOutOfGasPanic(&err)()
+
+	abciEventsStartIdx := len(startResult.CacheCtx.EventManager().Events())
+
+	method := startResult.Method
+	switch PrecompileMethod(method.Name) {
+	case StakingMethod_delegate:
+		bz, err = p.delegate(startResult, trueCaller, readonly)
+	case StakingMethod_undelegate:
+		bz, err = p.undelegate(startResult, trueCaller, readonly)
+	case StakingMethod_redelegate:
+		bz, err = p.redelegate(startResult, trueCaller, readonly)
+	case StakingMethod_withdrawDelegatorRewards:
+		bz, err = p.withdrawDelegatorRewards(startResult, trueCaller, readonly)
+	case StakingMethod_delegation:
+		bz, err = p.delegation(startResult, contract)
+	case StakingMethod_validator:
+		bz, err = p.validator(startResult, contract)
+	default:
+		// Note that this code path should be impossible to reach since
+		// "[decomposeInput]" parses methods directly from the ABI.
+		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+		return
+	}
+	// Gas consumed by a local gas meter
+	contract.UseGas(
+		startResult.CacheCtx.GasMeter().GasConsumed(),
+		evm.Config.Tracer,
+		tracing.GasChangeCallPrecompiledContract,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit extra events for the EVM if this is a transaction
+	// https://github.com/NibiruChain/nibiru/issues/2121
+	if isMutation[PrecompileMethod(startResult.Method.Name)] {
+		EmitEventAbciEvents(
+			startResult.CacheCtx,
+			startResult.StateDB,
+			startResult.CacheCtx.EventManager().Events()[abciEventsStartIdx:],
+			p.Address(),
+		)
+	}
+
+	return bz, err
+}
+
+func PrecompileStaking(keepers keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileStaking{
+		stakingKeeper: keepers.StakingKeeper,
+		distrKeeper:   keepers.DistrKeeper,
+	}
+}
+
+type precompileStaking struct {
+	stakingKeeper *stakingkeeper.Keeper
+	distrKeeper   distrkeeper.Keeper
+}
+
+// delegate: Implements "IStaking.delegate"
+//
+//	```solidity
+//	function delegate(
+//	    string memory validatorAddr,
+//	    uint256 amount
+//	) external returns (bool success);
+//	```
+//
+// The tokens come from the bank balance of the caller, so the EVM balance of
+// the caller decreases accordingly.
+func (p precompileStaking) delegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	valAddr, amount, err := parseArgsValidatorAndAmount(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := staking.NewMsgDelegate(
+		eth.EthAddrToNibiruAddr(caller),
+		valAddr,
+		sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), sdkmath.NewIntFromBigInt(amount)),
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Delegate(
+		sdk.WrapSDKContext(ctx), msg,
+	); err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	return method.Outputs.Pack(true)
+}
+
+// undelegate: Implements "IStaking.undelegate"
+//
+//	```solidity
+//	function undelegate(
+//	    string memory validatorAddr,
+//	    uint256 amount
+//	) external returns (int64 completionTime);
+//	```
+func (p precompileStaking) undelegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	valAddr, amount, err := parseArgsValidatorAndAmount(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := staking.NewMsgUndelegate(
+		eth.EthAddrToNibiruAddr(caller),
+		valAddr,
+		sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), sdkmath.NewIntFromBigInt(amount)),
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	resp, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Undelegate(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	return method.Outputs.Pack(resp.CompletionTime.Unix())
+}
+
+// redelegate: Implements "IStaking.redelegate"
+//
+//	```solidity
+//	function redelegate(
+//	    string memory srcValidatorAddr,
+//	    string memory dstValidatorAddr,
+//	    uint256 amount
+//	) external returns (int64 completionTime);
+//	```
+func (p precompileStaking) redelegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	srcValAddr, dstValAddr, amount, err := p.parseArgsRedelegate(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := staking.NewMsgBeginRedelegate(
+		eth.EthAddrToNibiruAddr(caller),
+		srcValAddr,
+		dstValAddr,
+		sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), sdkmath.NewIntFromBigInt(amount)),
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	resp, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).BeginRedelegate(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+	return method.Outputs.Pack(resp.CompletionTime.Unix())
+}
+
+func (p precompileStaking) parseArgsRedelegate(args []any) (
+	srcValAddr sdk.ValAddress,
+	dstValAddr sdk.ValAddress,
+	amount *big.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	srcValAddr, err = parseValAddr(args[argIdx], "string srcValidatorAddr")
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	dstValAddr, err = parseValAddr(args[argIdx], "string dstValidatorAddr")
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	amount, ok := args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	return
+}
+
+// withdrawDelegatorRewards: Implements "IStaking.withdrawDelegatorRewards"
+//
+//	```solidity
+//	function withdrawDelegatorRewards(
+//	    string memory validatorAddr
+//	) external returns (INibiruEvm.BankCoin[] memory rewards);
+//	```
+//
+// The rewards are sent to the withdraw address of the caller, which is the
+// caller itself unless it was changed with the "x/distribution" module.
+func (p precompileStaking) withdrawDelegatorRewards(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	if err := assertNumArgs(args, 1); err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+	valAddr, err := parseValAddr(args[0], "string validatorAddr")
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	msg := distr.NewMsgWithdrawDelegatorReward(eth.EthAddrToNibiruAddr(caller), valAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	resp, err := distrkeeper.NewMsgServerImpl(p.distrKeeper).WithdrawDelegatorReward(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return nil, ErrMethodCalled(method, err)
+	}
+
+	type BankCoin struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	}
+	rewards := make([]BankCoin, len(resp.Amount))
+	for i, coin := range resp.Amount {
+		rewards[i] = BankCoin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return method.Outputs.Pack(rewards)
+}
+
+// delegation: Implements "IStaking.delegation"
+//
+//	```solidity
+//	function delegation(
+//	    address delegator,
+//	    string memory validatorAddr
+//	) external view returns (uint256 shares, uint256 balance);
+//	```
+func (p precompileStaking) delegation(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 2); e != nil {
+		return nil, ErrInvalidArgs(e)
+	}
+	delegator, ok := args[0].(gethcommon.Address)
+	if !ok {
+		return nil, ErrInvalidArgs(ErrArgTypeValidation("address delegator", args[0]))
+	}
+	valAddr, err := parseValAddr(args[1], "string validatorAddr")
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	shares, balance := big.NewInt(0), big.NewInt(0)
+	del, found := p.stakingKeeper.GetDelegation(ctx, eth.EthAddrToNibiruAddr(delegator), valAddr)
+	if found {
+		shares = del.Shares.BigInt()
+		if val, found := p.stakingKeeper.GetValidator(ctx, valAddr); found {
+			balance = val.TokensFromShares(del.Shares).TruncateInt().BigInt()
+		}
+	}
+	return method.Outputs.Pack(shares, balance)
+}
+
+// validator: Implements "IStaking.validator"
+//
+//	```solidity
+//	function validator(
+//	    string memory validatorAddr
+//	) external view returns (Validator memory);
+//	```
+func (p precompileStaking) validator(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	ctx, method, args := start.CacheCtx, start.Method, start.Args
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 1); e != nil {
+		return nil, ErrInvalidArgs(e)
+	}
+	valAddr, err := parseValAddr(args[0], "string validatorAddr")
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	val, found := p.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, fmt.Errorf("validator \"%s\" does not exist", valAddr)
+	}
+	return method.Outputs.Pack(struct {
+		OperatorAddress string   `json:"operatorAddress"`
+		Moniker         string   `json:"moniker"`
+		Jailed          bool     `json:"jailed"`
+		Status          uint8    `json:"status"`
+		Tokens          *big.Int `json:"tokens"`
+		DelegatorShares *big.Int `json:"delegatorShares"`
+		CommissionRate  *big.Int `json:"commissionRate"`
+	}{
+		OperatorAddress: val.OperatorAddress,
+		Moniker:         val.Description.Moniker,
+		Jailed:          val.Jailed,
+		Status:          uint8(val.Status),
+		Tokens:          val.Tokens.BigInt(),
+		DelegatorShares: val.DelegatorShares.BigInt(),
+		CommissionRate:  val.Commission.Rate.BigInt(),
+	})
+}
+
+// parseArgsValidatorAndAmount parses the (string validatorAddr, uint256
+// amount) arguments shared by "delegate" and "undelegate".
+func parseArgsValidatorAndAmount(args []any) (
+	valAddr sdk.ValAddress,
+	amount *big.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	valAddr, err = parseValAddr(args[argIdx], "string validatorAddr")
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	amount, ok := args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	return
+}
+
+// parseValAddr parses a Bech32 validator operator address from an ABI
+// argument of type string.
+func parseValAddr(arg any, solidityHint string) (sdk.ValAddress, error) {
+	valAddrStr, ok := arg.(string)
+	if !ok {
+		return nil, ErrArgTypeValidation(solidityHint, arg)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator address \"%s\": %w", valAddrStr, err)
+	}
+	return valAddr, nil
+}
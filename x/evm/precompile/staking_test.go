@@ -0,0 +1,195 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+type StakingSuite struct {
+	suite.Suite
+}
+
+func TestStakingSuite(t *testing.T) {
+	suite.Run(t, new(StakingSuite))
+}
+
+func (s *StakingSuite) callStaking(
+	deps *evmtest.TestDeps, commit bool, method precompile.PrecompileMethod, args ...any,
+) (*evm.MsgEthereumTxResponse, error) {
+	input, err := embeds.SmartContract_Staking.ABI.Pack(string(method), args...)
+	s.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	return deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx,
+		evmObj,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Staking,
+		commit,
+		input,
+		keeper.Erc20GasLimitExecute,
+	)
+}
+
+func (s *StakingSuite) TestHappyPath() {
+	deps := evmtest.NewTestDeps()
+	val := deps.App.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	valAddr := val.OperatorAddress
+	bondDenom := deps.App.StakingKeeper.BondDenom(deps.Ctx)
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(10_000_000))),
+	))
+
+	s.Run("IStaking.validator", func() {
+		evmResp, err := s.callStaking(&deps, false, precompile.StakingMethod_validator, valAddr)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_Staking.ABI.Unpack(
+			string(precompile.StakingMethod_validator), evmResp.Ret,
+		)
+		s.Require().NoError(err)
+		gotVal := out[0].(struct {
+			OperatorAddress string   `json:"operatorAddress"`
+			Moniker         string   `json:"moniker"`
+			Jailed          bool     `json:"jailed"`
+			Status          uint8    `json:"status"`
+			Tokens          *big.Int `json:"tokens"`
+			DelegatorShares *big.Int `json:"delegatorShares"`
+			CommissionRate  *big.Int `json:"commissionRate"`
+		})
+		s.Equal(valAddr, gotVal.OperatorAddress)
+		s.Equal(uint8(stakingtypes.Bonded), gotVal.Status)
+		s.False(gotVal.Jailed)
+		s.Equal(val.Tokens.BigInt(), gotVal.Tokens)
+	})
+
+	s.Run("IStaking.delegate", func() {
+		evmResp, err := s.callStaking(
+			&deps, true, precompile.StakingMethod_delegate, valAddr, big.NewInt(4_000_000),
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(evmResp.VmError)
+
+		del, found := deps.App.StakingKeeper.GetDelegation(
+			deps.Ctx, deps.Sender.NibiruAddr, val.GetOperator(),
+		)
+		s.Require().True(found)
+		s.Equal(int64(4_000_000), val.TokensFromShares(del.Shares).TruncateInt64())
+		evmtest.AssertBankBalanceEqualWithDescription(
+			s.T(), deps, bondDenom, deps.Sender.EthAddr, big.NewInt(6_000_000),
+			"expect delegated tokens to leave the bank balance",
+		)
+
+		s.T().Log("Expect ABCI events of the delegation in the EVM logs")
+		s.NotEmpty(evmResp.Logs)
+		for _, log := range evmResp.Logs {
+			s.Equal(precompile.PrecompileAddr_Staking.Hex(), log.Address)
+		}
+	})
+
+	s.Run("IStaking.delegation", func() {
+		evmResp, err := s.callStaking(
+			&deps, false, precompile.StakingMethod_delegation, deps.Sender.EthAddr, valAddr,
+		)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_Staking.ABI.Unpack(
+			string(precompile.StakingMethod_delegation), evmResp.Ret,
+		)
+		s.Require().NoError(err)
+		s.Require().Len(out, 2)
+		s.Positive(out[0].(*big.Int).Sign())
+		s.Equal(big.NewInt(4_000_000), out[1].(*big.Int))
+	})
+
+	s.Run("IStaking.delegation of an account without delegations", func() {
+		evmResp, err := s.callStaking(
+			&deps, false, precompile.StakingMethod_delegation, gethcommon.Address{}, valAddr,
+		)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_Staking.ABI.Unpack(
+			string(precompile.StakingMethod_delegation), evmResp.Ret,
+		)
+		s.Require().NoError(err)
+		s.Zero(out[0].(*big.Int).Sign())
+		s.Zero(out[1].(*big.Int).Sign())
+	})
+
+	s.Run("IStaking.withdrawDelegatorRewards", func() {
+		evmResp, err := s.callStaking(
+			&deps, true, precompile.StakingMethod_withdrawDelegatorRewards, valAddr,
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(evmResp.VmError)
+	})
+
+	s.Run("IStaking.undelegate", func() {
+		evmResp, err := s.callStaking(
+			&deps, true, precompile.StakingMethod_undelegate, valAddr, big.NewInt(1_000_000),
+		)
+		s.Require().NoError(err)
+
+		var completionTime int64
+		s.Require().NoError(embeds.SmartContract_Staking.ABI.UnpackIntoInterface(
+			&completionTime, string(precompile.StakingMethod_undelegate), evmResp.Ret,
+		))
+		s.Greater(completionTime, deps.Ctx.BlockTime().Unix())
+
+		ubd, found := deps.App.StakingKeeper.GetUnbondingDelegation(
+			deps.Ctx, deps.Sender.NibiruAddr, val.GetOperator(),
+		)
+		s.Require().True(found)
+		s.Require().Len(ubd.Entries, 1)
+		s.Equal(int64(1_000_000), ubd.Entries[0].Balance.Int64())
+	})
+}
+
+func (s *StakingSuite) TestFailures() {
+	deps := evmtest.NewTestDeps()
+	valAddr := deps.App.StakingKeeper.GetValidators(deps.Ctx, 1)[0].OperatorAddress
+
+	s.Run("invalid validator address", func() {
+		_, err := s.callStaking(
+			&deps, true, precompile.StakingMethod_delegate, "not-a-valoper", big.NewInt(1),
+		)
+		s.Require().ErrorContains(err, "invalid validator address")
+	})
+
+	s.Run("delegate more than the balance", func() {
+		_, err := s.callStaking(
+			&deps, true, precompile.StakingMethod_delegate, valAddr, big.NewInt(1e18),
+		)
+		s.Require().ErrorContains(err, "insufficient funds")
+	})
+
+	s.Run("redelegate to the same validator", func() {
+		_, err := s.callStaking(
+			&deps, true, precompile.StakingMethod_redelegate, valAddr, valAddr, big.NewInt(1),
+		)
+		s.Require().Error(err)
+	})
+
+	s.Run("unknown validator", func() {
+		_, err := s.callStaking(
+			&deps, false, precompile.StakingMethod_validator,
+			sdk.ValAddress(deps.Sender.NibiruAddr).String(),
+		)
+		s.Require().ErrorContains(err, "does not exist")
+	})
+}
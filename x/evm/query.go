@@ -3,6 +3,7 @@ package evm
 
 import (
 	"fmt"
+	"strings"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,24 @@ func (m QueryTraceBlockRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 	return nil
 }
 
+func (m EthCallRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.PendingTxs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m QueryPendingAccountRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.PendingTxs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (req *QueryEthAccountRequest) Validate() (isBech32 bool, err error) {
 	if req == nil {
 		return isBech32, common.ErrNilGrpcMsg
@@ -86,6 +105,52 @@ func (req *QueryBalanceRequest) Validate() error {
 	return nil
 }
 
+func (req *QueryPendingAccountRequest) Validate() error {
+	if req == nil {
+		return common.ErrNilGrpcMsg
+	}
+	if err := eth.ValidateAddress(req.Address); err != nil {
+		return status.Error(
+			codes.InvalidArgument,
+			ErrZeroAddress.Error(),
+		)
+	}
+	return ValidatePendingTxs(req.PendingTxs)
+}
+
+const (
+	// MaxPendingTxs is the most mempool txs that a query of the "pending"
+	// state can replay.
+	MaxPendingTxs = 1_000
+	// MaxPendingTxsGas is the most gas that the mempool txs replayed by a
+	// query of the "pending" state can use, summed over their gas limits.
+	MaxPendingTxsGas uint64 = 100_000_000
+)
+
+// ValidatePendingTxs checks that the mempool txs of a query of the "pending"
+// state fit in [MaxPendingTxs] and [MaxPendingTxsGas], which bound the work
+// that the replay does for a single query.
+func ValidatePendingTxs(pendingTxs []*MsgEthereumTx) error {
+	if len(pendingTxs) > MaxPendingTxs {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"too many pending txs: %d > %d", len(pendingTxs), MaxPendingTxs,
+		)
+	}
+	var totalGas uint64
+	for _, tx := range pendingTxs {
+		gas := tx.GetGas()
+		if gas > MaxPendingTxsGas-totalGas {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"gas of the pending txs exceeds %d", MaxPendingTxsGas,
+			)
+		}
+		totalGas += gas
+	}
+	return nil
+}
+
 func (req *QueryStorageRequest) Validate() error {
 	if req == nil {
 		return common.ErrNilGrpcMsg
@@ -117,7 +182,7 @@ func (req *EthCallRequest) Validate() error {
 	if req == nil {
 		return common.ErrNilGrpcMsg
 	}
-	return nil
+	return ValidatePendingTxs(req.PendingTxs)
 }
 
 func (req *QueryTraceTxRequest) Validate() error {
@@ -141,3 +206,36 @@ func (req *QueryTraceBlockRequest) Validate() error {
 	}
 	return nil
 }
+
+func (req *SimulateV1Request) Validate() error {
+	if req == nil {
+		return common.ErrNilGrpcMsg
+	}
+	return nil
+}
+
+func (req *QueryFunTokenMappingsRequest) Validate() error {
+	if req == nil {
+		return common.ErrNilGrpcMsg
+	}
+	if _, ok := FunTokenOrigin_name[int32(req.Origin)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid fun token origin: %d", req.Origin)
+	}
+	return nil
+}
+
+// Matches returns true if the given [FunToken] mapping satisfies the filters of
+// the request.
+func (req *QueryFunTokenMappingsRequest) Matches(funToken FunToken) bool {
+	switch req.Origin {
+	case FunTokenOrigin_FUN_TOKEN_ORIGIN_COIN:
+		if !funToken.IsMadeFromCoin {
+			return false
+		}
+	case FunTokenOrigin_FUN_TOKEN_ORIGIN_ERC20:
+		if funToken.IsMadeFromCoin {
+			return false
+		}
+	}
+	return strings.HasPrefix(funToken.BankDenom, req.BankDenomPrefix)
+}
@@ -36,6 +36,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FunTokenOrigin: Filter on the origin of "FunToken" mappings, which is given
+// by their "is_made_from_coin" field.
+type FunTokenOrigin int32
+
+const (
+	// FUN_TOKEN_ORIGIN_UNSPECIFIED: Mappings of any origin.
+	FunTokenOrigin_FUN_TOKEN_ORIGIN_UNSPECIFIED FunTokenOrigin = 0
+	// FUN_TOKEN_ORIGIN_COIN: Mappings created from a Bank Coin
+	// (is_made_from_coin=true).
+	FunTokenOrigin_FUN_TOKEN_ORIGIN_COIN FunTokenOrigin = 1
+	// FUN_TOKEN_ORIGIN_ERC20: Mappings created from an ERC20
+	// (is_made_from_coin=false).
+	FunTokenOrigin_FUN_TOKEN_ORIGIN_ERC20 FunTokenOrigin = 2
+)
+
+var FunTokenOrigin_name = map[int32]string{
+	0: "FUN_TOKEN_ORIGIN_UNSPECIFIED",
+	1: "FUN_TOKEN_ORIGIN_COIN",
+	2: "FUN_TOKEN_ORIGIN_ERC20",
+}
+
+var FunTokenOrigin_value = map[string]int32{
+	"FUN_TOKEN_ORIGIN_UNSPECIFIED": 0,
+	"FUN_TOKEN_ORIGIN_COIN":        1,
+	"FUN_TOKEN_ORIGIN_ERC20":       2,
+}
+
+func (x FunTokenOrigin) String() string {
+	return proto.EnumName(FunTokenOrigin_name, int32(x))
+}
+
+func (FunTokenOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{0}
+}
+
 // QueryEthAccountRequest is the request type for the Query/Account RPC method.
 type QueryEthAccountRequest struct {
 	// address is the Ethereum hex address or nibi Bech32 address to query the account for.
@@ -367,6 +402,114 @@ func (m *QueryBalanceResponse) GetBalanceWei() string {
 	return ""
 }
 
+// QueryPendingAccountRequest is the request type for the Query/PendingAccount
+// RPC method.
+type QueryPendingAccountRequest struct {
+	// address is the ethereum hex address to query the account for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pending_txs are the mempool txs applied to the state, in order, before
+	// reading the account. See EthCallRequest.pending_txs.
+	PendingTxs []*MsgEthereumTx `protobuf:"bytes,2,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
+}
+
+func (m *QueryPendingAccountRequest) Reset()         { *m = QueryPendingAccountRequest{} }
+func (m *QueryPendingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAccountRequest) ProtoMessage()    {}
+func (*QueryPendingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{6}
+}
+func (m *QueryPendingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAccountRequest.Merge(m, src)
+}
+func (m *QueryPendingAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAccountRequest proto.InternalMessageInfo
+
+// QueryPendingAccountResponse is the response type for the Query/PendingAccount
+// RPC method.
+type QueryPendingAccountResponse struct {
+	// balance_wei is the balance of the EVM denomination in units of wei.
+	BalanceWei string `protobuf:"bytes,1,opt,name=balance_wei,json=balanceWei,proto3" json:"balance_wei,omitempty"`
+	// nonce is the nonce of the account.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// applied_txs is the number of pending txs that could be applied.
+	AppliedTxs uint64 `protobuf:"varint,3,opt,name=applied_txs,json=appliedTxs,proto3" json:"applied_txs,omitempty"`
+}
+
+func (m *QueryPendingAccountResponse) Reset()         { *m = QueryPendingAccountResponse{} }
+func (m *QueryPendingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAccountResponse) ProtoMessage()    {}
+func (*QueryPendingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{7}
+}
+func (m *QueryPendingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAccountResponse.Merge(m, src)
+}
+func (m *QueryPendingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAccountResponse proto.InternalMessageInfo
+
+func (m *QueryPendingAccountResponse) GetBalanceWei() string {
+	if m != nil {
+		return m.BalanceWei
+	}
+	return ""
+}
+
+func (m *QueryPendingAccountResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *QueryPendingAccountResponse) GetAppliedTxs() uint64 {
+	if m != nil {
+		return m.AppliedTxs
+	}
+	return 0
+}
+
 // QueryStorageRequest is the request type for the Query/Storage RPC method.
 type QueryStorageRequest struct {
 	// address is the ethereum hex address to query the storage state for.
@@ -379,7 +522,7 @@ func (m *QueryStorageRequest) Reset()         { *m = QueryStorageRequest{} }
 func (m *QueryStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageRequest) ProtoMessage()    {}
 func (*QueryStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{6}
+	return fileDescriptor_ffa36cdc5add14ed, []int{8}
 }
 func (m *QueryStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +562,7 @@ func (m *QueryStorageResponse) Reset()         { *m = QueryStorageResponse{} }
 func (m *QueryStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageResponse) ProtoMessage()    {}
 func (*QueryStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{7}
+	return fileDescriptor_ffa36cdc5add14ed, []int{9}
 }
 func (m *QueryStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +608,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{8}
+	return fileDescriptor_ffa36cdc5add14ed, []int{10}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +648,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{9}
+	return fileDescriptor_ffa36cdc5add14ed, []int{11}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +696,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{10}
+	return fileDescriptor_ffa36cdc5add14ed, []int{12}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,7 +737,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{11}
+	return fileDescriptor_ffa36cdc5add14ed, []int{13}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +788,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{12}
+	return fileDescriptor_ffa36cdc5add14ed, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +827,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{13}
+	return fileDescriptor_ffa36cdc5add14ed, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,13 +873,26 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// state_overrides is the JSON-encoded set of account overrides (balance,
+	// nonce, code, state, stateDiff) applied to the StateDB before execution.
+	// It uses the same json format as the json rpc api.
+	StateOverrides []byte `protobuf:"bytes,5,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block_overrides is the JSON-encoded set of block context overrides
+	// (number, time, feeRecipient, baseFeePerGas) used during execution.
+	// It uses the same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+	// pending_txs are the mempool txs applied to the state, in order, before
+	// execution, which runs it on the "pending" state. Txs that a block could
+	// not include, like txs with a nonce gap or txs whose sender cannot pay for
+	// them, are skipped.
+	PendingTxs []*MsgEthereumTx `protobuf:"bytes,7,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{14}
+	return fileDescriptor_ffa36cdc5add14ed, []int{16}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,24 +949,53 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
-// EstimateGasResponse defines EstimateGas response
-type EstimateGasResponse struct {
-	// gas returns the estimated gas
-	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+func (m *EthCallRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
 }
 
-func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
-func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateGasResponse) ProtoMessage()    {}
-func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{15}
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
 }
-func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *EthCallRequest) GetPendingTxs() []*MsgEthereumTx {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return nil
+}
+
+// SimulateV1Request defines the request type for the Query/SimulateV1 RPC
+// method ("eth_simulateV1").
+type SimulateV1Request struct {
+	// opts is the JSON-encoded simulation options ("blockStateCalls",
+	// "validation"). It uses the same json format as the json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the total amount of gas available to all simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{17}
+}
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateGasResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -820,60 +1005,65 @@ func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EstimateGasResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateGasResponse.Merge(m, src)
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
 }
-func (m *EstimateGasResponse) XXX_Size() int {
+func (m *SimulateV1Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateGasResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateGasResponse.DiscardUnknown(m)
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateGasResponse proto.InternalMessageInfo
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
 
-func (m *EstimateGasResponse) GetGas() uint64 {
+func (m *SimulateV1Request) GetOpts() []byte {
 	if m != nil {
-		return m.Gas
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
 	}
 	return 0
 }
 
-// QueryTraceTxRequest defines TraceTx request
-type QueryTraceTxRequest struct {
-	// msg is the MsgEthereumTx for the requested transaction
-	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// trace_config holds extra parameters to trace functions.
-	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
-	// predecessors is an array of transactions included in the same block
-	// need to be replayed first to get correct context for tracing.
-	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
-	// block_number of requested transaction
-	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// block_hash of requested transaction
-	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block_time of requested transaction
-	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
-	// proposer_address is the proposer of the requested block
-	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
-	// chain_id is the the eip155 chain id parsed from the requested block header
-	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// block_max_gas of the block of the requested transaction
-	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
 }
 
-func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
-func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTraceTxRequest) ProtoMessage()    {}
-func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{16}
+func (m *SimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
-func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
+
+// SimulateV1Response defines the response type for the Query/SimulateV1 RPC
+// method.
+type SimulateV1Response struct {
+	// blocks holds the results of each simulated block in order
+	Blocks []SimulatedBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTraceTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -883,99 +1073,144 @@ func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTraceTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTraceTxRequest.Merge(m, src)
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
 }
-func (m *QueryTraceTxRequest) XXX_Size() int {
+func (m *SimulateV1Response) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTraceTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTraceTxRequest.DiscardUnknown(m)
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTraceTxRequest proto.InternalMessageInfo
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
 
-func (m *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+func (m *SimulateV1Response) GetBlocks() []SimulatedBlock {
 	if m != nil {
-		return m.Msg
+		return m.Blocks
 	}
 	return nil
 }
 
-func (m *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
-	if m != nil {
-		return m.TraceConfig
-	}
-	return nil
+// SimulatedBlock is the result of a simulated block in "eth_simulateV1".
+type SimulatedBlock struct {
+	// number is the block number of the simulated block
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// timestamp is the block time of the simulated block in Unix seconds
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// gas_limit is the block gas limit used for the simulated block
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_used is the total gas used by the calls in the simulated block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee_recipient is the hex address of the block coinbase
+	FeeRecipient string `protobuf:"bytes,5,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// base_fee is the base fee in wei per gas used for the simulated block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// calls holds the result of each call in the simulated block in order
+	Calls []SimulatedCallResult `protobuf:"bytes,7,rep,name=calls,proto3" json:"calls"`
+}
+
+func (m *SimulatedBlock) Reset()         { *m = SimulatedBlock{} }
+func (m *SimulatedBlock) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBlock) ProtoMessage()    {}
+func (*SimulatedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{19}
 }
-
-func (m *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
-	if m != nil {
-		return m.Predecessors
+func (m *SimulatedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SimulatedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBlock.Merge(m, src)
+}
+func (m *SimulatedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBlock.DiscardUnknown(m)
 }
 
-func (m *QueryTraceTxRequest) GetBlockNumber() int64 {
+var xxx_messageInfo_SimulatedBlock proto.InternalMessageInfo
+
+func (m *SimulatedBlock) GetNumber() uint64 {
 	if m != nil {
-		return m.BlockNumber
+		return m.Number
 	}
 	return 0
 }
 
-func (m *QueryTraceTxRequest) GetBlockHash() string {
+func (m *SimulatedBlock) GetTimestamp() uint64 {
 	if m != nil {
-		return m.BlockHash
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryTraceTxRequest) GetBlockTime() time.Time {
+func (m *SimulatedBlock) GetGasLimit() uint64 {
 	if m != nil {
-		return m.BlockTime
+		return m.GasLimit
 	}
-	return time.Time{}
+	return 0
 }
 
-func (m *QueryTraceTxRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+func (m *SimulatedBlock) GetGasUsed() uint64 {
 	if m != nil {
-		return m.ProposerAddress
+		return m.GasUsed
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryTraceTxRequest) GetChainId() int64 {
+func (m *SimulatedBlock) GetFeeRecipient() string {
 	if m != nil {
-		return m.ChainId
+		return m.FeeRecipient
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryTraceTxRequest) GetBlockMaxGas() int64 {
+func (m *SimulatedBlock) GetCalls() []SimulatedCallResult {
 	if m != nil {
-		return m.BlockMaxGas
+		return m.Calls
 	}
-	return 0
-}
-
-// QueryTraceTxResponse defines TraceTx response
-type QueryTraceTxResponse struct {
-	// data is the response serialized in bytes
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	return nil
 }
 
-func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
-func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTraceTxResponse) ProtoMessage()    {}
-func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{17}
+// SimulatedCallResult is the result of a single call in "eth_simulateV1".
+type SimulatedCallResult struct {
+	// return_data is the data returned by the call
+	ReturnData []byte `protobuf:"bytes,1,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+	// logs contains the logs emitted by the call
+	Logs []Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs"`
+	// gas_used specifies how much gas was consumed by the call
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by the EVM, empty if the call succeeded
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// revert_reason is the decoded revert reason if the call reverted
+	RevertReason string `protobuf:"bytes,5,opt,name=revert_reason,json=revertReason,proto3" json:"revert_reason,omitempty"`
+}
+
+func (m *SimulatedCallResult) Reset()         { *m = SimulatedCallResult{} }
+func (m *SimulatedCallResult) String() string { return proto.CompactTextString(m) }
+func (*SimulatedCallResult) ProtoMessage()    {}
+func (*SimulatedCallResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{20}
 }
-func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *SimulatedCallResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTraceTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulatedCallResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTraceTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulatedCallResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -985,13 +1220,252 @@ func (m *QueryTraceTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryTraceTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTraceTxResponse.Merge(m, src)
+func (m *SimulatedCallResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedCallResult.Merge(m, src)
 }
-func (m *QueryTraceTxResponse) XXX_Size() int {
+func (m *SimulatedCallResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTraceTxResponse) XXX_DiscardUnknown() {
+func (m *SimulatedCallResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedCallResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedCallResult proto.InternalMessageInfo
+
+func (m *SimulatedCallResult) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+func (m *SimulatedCallResult) GetLogs() []Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *SimulatedCallResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SimulatedCallResult) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *SimulatedCallResult) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+// EstimateGasResponse defines EstimateGas response
+type EstimateGasResponse struct {
+	// gas returns the estimated gas
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()    {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{21}
+}
+func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasResponse.Merge(m, src)
+}
+func (m *EstimateGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasResponse proto.InternalMessageInfo
+
+func (m *EstimateGasResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// QueryTraceTxRequest defines TraceTx request
+type QueryTraceTxRequest struct {
+	// msg is the MsgEthereumTx for the requested transaction
+	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// predecessors is an array of transactions included in the same block
+	// need to be replayed first to get correct context for tracing.
+	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
+	// block_number of requested transaction
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash of requested transaction
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of requested transaction
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block of the requested transaction
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
+func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceTxRequest) ProtoMessage()    {}
+func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{22}
+}
+func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceTxRequest.Merge(m, src)
+}
+func (m *QueryTraceTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceTxRequest proto.InternalMessageInfo
+
+func (m *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+	if m != nil {
+		return m.Predecessors
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceTxRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceTxRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceTxRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceTxRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryTraceTxResponse defines TraceTx response
+type QueryTraceTxResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
+func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceTxResponse) ProtoMessage()    {}
+func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{23}
+}
+func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceTxResponse.Merge(m, src)
+}
+func (m *QueryTraceTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceTxResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_QueryTraceTxResponse.DiscardUnknown(m)
 }
 
@@ -1028,7 +1502,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+	return fileDescriptor_ffa36cdc5add14ed, []int{24}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1597,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{19}
+	return fileDescriptor_ffa36cdc5add14ed, []int{25}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1642,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{20}
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1684,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{21}
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,7 +1723,7 @@ func (m *QueryFunTokenMappingRequest) Reset()         { *m = QueryFunTokenMappin
 func (m *QueryFunTokenMappingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFunTokenMappingRequest) ProtoMessage()    {}
 func (*QueryFunTokenMappingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{22}
+	return fileDescriptor_ffa36cdc5add14ed, []int{28}
 }
 func (m *QueryFunTokenMappingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1761,7 @@ func (m *QueryFunTokenMappingResponse) Reset()         { *m = QueryFunTokenMappi
 func (m *QueryFunTokenMappingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFunTokenMappingResponse) ProtoMessage()    {}
 func (*QueryFunTokenMappingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{23}
+	return fileDescriptor_ffa36cdc5add14ed, []int{29}
 }
 func (m *QueryFunTokenMappingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,137 +1790,269 @@ func (m *QueryFunTokenMappingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFunTokenMappingResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
-	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
-	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "eth.evm.v1.QueryValidatorAccountRequest")
-	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "eth.evm.v1.QueryValidatorAccountResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "eth.evm.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "eth.evm.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "eth.evm.v1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "eth.evm.v1.QueryStorageResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "eth.evm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "eth.evm.v1.QueryCodeResponse")
-	proto.RegisterType((*QueryTxLogsRequest)(nil), "eth.evm.v1.QueryTxLogsRequest")
-	proto.RegisterType((*QueryTxLogsResponse)(nil), "eth.evm.v1.QueryTxLogsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "eth.evm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "eth.evm.v1.QueryParamsResponse")
-	proto.RegisterType((*EthCallRequest)(nil), "eth.evm.v1.EthCallRequest")
-	proto.RegisterType((*EstimateGasResponse)(nil), "eth.evm.v1.EstimateGasResponse")
-	proto.RegisterType((*QueryTraceTxRequest)(nil), "eth.evm.v1.QueryTraceTxRequest")
-	proto.RegisterType((*QueryTraceTxResponse)(nil), "eth.evm.v1.QueryTraceTxResponse")
-	proto.RegisterType((*QueryTraceBlockRequest)(nil), "eth.evm.v1.QueryTraceBlockRequest")
-	proto.RegisterType((*QueryTraceBlockResponse)(nil), "eth.evm.v1.QueryTraceBlockResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "eth.evm.v1.QueryBaseFeeRequest")
+type QueryFunTokenMappingsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// origin: Optional filter on the "is_made_from_coin" field of the mappings.
+	Origin FunTokenOrigin `protobuf:"varint,2,opt,name=origin,proto3,enum=eth.evm.v1.FunTokenOrigin" json:"origin,omitempty"`
+	// bank_denom_prefix: Optional filter that only returns mappings whose bank
+	// denomination starts with the given prefix, like "ibc/" or "erc20/".
+	BankDenomPrefix string `protobuf:"bytes,3,opt,name=bank_denom_prefix,json=bankDenomPrefix,proto3" json:"bank_denom_prefix,omitempty"`
+}
+
+func (m *QueryFunTokenMappingsRequest) Reset()         { *m = QueryFunTokenMappingsRequest{} }
+func (m *QueryFunTokenMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenMappingsRequest) ProtoMessage()    {}
+func (*QueryFunTokenMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{30}
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenMappingsRequest.Merge(m, src)
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenMappingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenMappingsRequest proto.InternalMessageInfo
+
+type QueryFunTokenMappingsResponse struct {
+	// fun_tokens: Mappings between Bank Coins and ERC20 contract addresses in
+	// the requested page.
+	FunTokens []FunToken `protobuf:"bytes,1,rep,name=fun_tokens,json=funTokens,proto3" json:"fun_tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// count: Total number of mappings that match the filters.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryFunTokenMappingsResponse) Reset()         { *m = QueryFunTokenMappingsResponse{} }
+func (m *QueryFunTokenMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenMappingsResponse) ProtoMessage()    {}
+func (*QueryFunTokenMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{31}
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenMappingsResponse.Merge(m, src)
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenMappingsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("eth.evm.v1.FunTokenOrigin", FunTokenOrigin_name, FunTokenOrigin_value)
+	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
+	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
+	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "eth.evm.v1.QueryValidatorAccountRequest")
+	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "eth.evm.v1.QueryValidatorAccountResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "eth.evm.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "eth.evm.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryPendingAccountRequest)(nil), "eth.evm.v1.QueryPendingAccountRequest")
+	proto.RegisterType((*QueryPendingAccountResponse)(nil), "eth.evm.v1.QueryPendingAccountResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "eth.evm.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "eth.evm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "eth.evm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "eth.evm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryTxLogsRequest)(nil), "eth.evm.v1.QueryTxLogsRequest")
+	proto.RegisterType((*QueryTxLogsResponse)(nil), "eth.evm.v1.QueryTxLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "eth.evm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "eth.evm.v1.QueryParamsResponse")
+	proto.RegisterType((*EthCallRequest)(nil), "eth.evm.v1.EthCallRequest")
+	proto.RegisterType((*SimulateV1Request)(nil), "eth.evm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "eth.evm.v1.SimulateV1Response")
+	proto.RegisterType((*SimulatedBlock)(nil), "eth.evm.v1.SimulatedBlock")
+	proto.RegisterType((*SimulatedCallResult)(nil), "eth.evm.v1.SimulatedCallResult")
+	proto.RegisterType((*EstimateGasResponse)(nil), "eth.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryTraceTxRequest)(nil), "eth.evm.v1.QueryTraceTxRequest")
+	proto.RegisterType((*QueryTraceTxResponse)(nil), "eth.evm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceBlockRequest)(nil), "eth.evm.v1.QueryTraceBlockRequest")
+	proto.RegisterType((*QueryTraceBlockResponse)(nil), "eth.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "eth.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "eth.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryFunTokenMappingRequest)(nil), "eth.evm.v1.QueryFunTokenMappingRequest")
 	proto.RegisterType((*QueryFunTokenMappingResponse)(nil), "eth.evm.v1.QueryFunTokenMappingResponse")
+	proto.RegisterType((*QueryFunTokenMappingsRequest)(nil), "eth.evm.v1.QueryFunTokenMappingsRequest")
+	proto.RegisterType((*QueryFunTokenMappingsResponse)(nil), "eth.evm.v1.QueryFunTokenMappingsResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x5b, 0xc5,
-	0x16, 0x8f, 0x63, 0x27, 0x76, 0x8e, 0xd3, 0x24, 0x6f, 0x9a, 0x36, 0x89, 0x93, 0xd8, 0xc9, 0xcd,
-	0x7b, 0x49, 0xda, 0xd7, 0xde, 0xfb, 0xe2, 0x3e, 0xbd, 0xa7, 0x57, 0xbd, 0x0a, 0xea, 0x28, 0x0d,
-	0xa5, 0x7f, 0xd4, 0x9a, 0x00, 0x12, 0x08, 0x59, 0x63, 0x7b, 0x72, 0x7d, 0x15, 0xfb, 0x8e, 0xeb,
-	0x19, 0xbb, 0x0e, 0x25, 0x1b, 0xba, 0x41, 0x42, 0x95, 0x2a, 0xf1, 0x05, 0xba, 0xe2, 0x23, 0xc0,
-	0x57, 0xe8, 0x8e, 0x4a, 0x6c, 0x10, 0x8b, 0x82, 0x5a, 0x16, 0xac, 0x59, 0xb2, 0x42, 0xf3, 0xcf,
-	0xbe, 0xb6, 0xaf, 0x13, 0xaa, 0xc2, 0x8e, 0xd5, 0x9d, 0x39, 0x73, 0xfe, 0xfc, 0xe6, 0xcc, 0xb9,
-	0xe7, 0xfc, 0xe0, 0x2c, 0xe1, 0x15, 0x87, 0xb4, 0x6a, 0x4e, 0x6b, 0xcb, 0xb9, 0xd7, 0x24, 0x8d,
-	0x43, 0xbb, 0xde, 0xa0, 0x9c, 0x22, 0x20, 0xbc, 0x62, 0x93, 0x56, 0xcd, 0x6e, 0x6d, 0xa5, 0xce,
-	0x97, 0x28, 0xab, 0x51, 0xe6, 0x14, 0x31, 0x23, 0x4a, 0xc9, 0x69, 0x6d, 0x15, 0x09, 0xc7, 0x5b,
-	0x4e, 0x1d, 0xbb, 0x9e, 0x8f, 0xb9, 0x47, 0x7d, 0x65, 0x97, 0x9a, 0x0d, 0xf8, 0x13, 0xe6, 0x4a,
-	0x7a, 0x3a, 0x20, 0xe5, 0x6d, 0xa3, 0xea, 0x52, 0x97, 0xca, 0xa5, 0x23, 0x56, 0x5a, 0xba, 0xe4,
-	0x52, 0xea, 0x56, 0x89, 0x83, 0xeb, 0x9e, 0x83, 0x7d, 0x9f, 0x72, 0xe9, 0x9d, 0xe9, 0xd3, 0x8c,
-	0x3e, 0x95, 0xbb, 0x62, 0x73, 0xdf, 0xe1, 0x5e, 0x8d, 0x30, 0x8e, 0x6b, 0x75, 0xa5, 0x60, 0xfd,
-	0x1f, 0xce, 0xde, 0x15, 0x08, 0x77, 0x78, 0xe5, 0x6a, 0xa9, 0x44, 0x9b, 0x3e, 0xcf, 0x93, 0x7b,
-	0x4d, 0xc2, 0x38, 0x9a, 0x87, 0x38, 0x2e, 0x97, 0x1b, 0x84, 0xb1, 0xf9, 0xc8, 0x4a, 0x64, 0x73,
-	0x22, 0x6f, 0xb6, 0x97, 0x13, 0x9f, 0x3d, 0xc9, 0x8c, 0xfc, 0xfc, 0x24, 0x33, 0x62, 0x7d, 0x13,
-	0x81, 0xb9, 0x01, 0x73, 0x56, 0xa7, 0x3e, 0x23, 0xc2, 0xbe, 0x88, 0xab, 0xd8, 0x2f, 0x11, 0x63,
-	0xaf, 0xb7, 0x28, 0x03, 0x49, 0xbd, 0x2c, 0xdc, 0x27, 0xde, 0xfc, 0xa8, 0x3c, 0x05, 0x2d, 0x7a,
-	0x9f, 0x78, 0x68, 0x11, 0x26, 0x4a, 0xb4, 0x4c, 0x0a, 0x15, 0xcc, 0x2a, 0xf3, 0x51, 0x79, 0x9c,
-	0x10, 0x82, 0xb7, 0x30, 0xab, 0xa0, 0x59, 0x18, 0xf3, 0xa9, 0xf0, 0x1a, 0x5b, 0x89, 0x6c, 0xc6,
-	0xf2, 0x6a, 0x23, 0x7c, 0x12, 0x5e, 0x29, 0x18, 0xc4, 0x63, 0xca, 0x27, 0xe1, 0x95, 0xab, 0x4a,
-	0x82, 0xfe, 0x01, 0x53, 0x45, 0x52, 0xaa, 0x5c, 0xca, 0x76, 0x74, 0xc6, 0xa5, 0xce, 0x29, 0x25,
-	0xd5, 0x6a, 0xd6, 0x0d, 0x58, 0x92, 0x17, 0x7a, 0x0f, 0x57, 0xbd, 0x32, 0xe6, 0xb4, 0xd1, 0x97,
-	0x95, 0x55, 0x98, 0x2c, 0x51, 0x9f, 0x15, 0x7a, 0x53, 0x93, 0x14, 0xb2, 0xab, 0x03, 0xe9, 0xf9,
-	0x3c, 0x02, 0xcb, 0x43, 0xbc, 0xe9, 0x24, 0x6d, 0xc0, 0x34, 0x56, 0xa2, 0x3e, 0x8f, 0x53, 0x5a,
-	0x6c, 0xe0, 0xa7, 0x20, 0xc1, 0x04, 0x04, 0x71, 0xf1, 0x51, 0x79, 0xf1, 0xce, 0x5e, 0x5c, 0xcd,
-	0x38, 0xf1, 0x9b, 0xb5, 0x22, 0x69, 0xc8, 0x9c, 0xc5, 0xf2, 0xa7, 0xb4, 0xf4, 0xb6, 0x14, 0x5a,
-	0xff, 0x83, 0xd3, 0x12, 0x4c, 0x4e, 0x25, 0xfa, 0x55, 0xde, 0xf9, 0x2e, 0xcc, 0xf6, 0x9a, 0xbe,
-	0xf6, 0x1b, 0x5b, 0x37, 0x34, 0x9a, 0x77, 0x38, 0x6d, 0x60, 0xf7, 0x64, 0x34, 0x68, 0x06, 0xa2,
-	0x07, 0xe4, 0x50, 0x7b, 0x12, 0xcb, 0x00, 0xbe, 0x0b, 0x1a, 0x5f, 0xc7, 0x99, 0xc6, 0x37, 0x0b,
-	0x63, 0x2d, 0x5c, 0x6d, 0x1a, 0x74, 0x6a, 0x63, 0xfd, 0x07, 0x66, 0xa4, 0xf6, 0x36, 0x2d, 0xbf,
-	0x52, 0x16, 0x36, 0xe0, 0x6f, 0x01, 0x3b, 0x1d, 0x02, 0x41, 0x4c, 0x94, 0xa6, 0xb4, 0x9a, 0xcc,
-	0xcb, 0xb5, 0xf5, 0x31, 0x20, 0xa9, 0xb8, 0xd7, 0xbe, 0x49, 0x5d, 0x66, 0x42, 0x20, 0x88, 0xc9,
-	0x82, 0x56, 0xfe, 0xe5, 0x1a, 0x5d, 0x03, 0xe8, 0xb6, 0x04, 0x79, 0xb7, 0x64, 0x76, 0xdd, 0x56,
-	0xfd, 0xc3, 0x16, 0xfd, 0xc3, 0x56, 0x4d, 0x46, 0xf7, 0x0f, 0xfb, 0x4e, 0x37, 0x55, 0xf9, 0x80,
-	0x65, 0x00, 0xe4, 0xc3, 0x88, 0x4e, 0xac, 0x09, 0xae, 0x71, 0xae, 0x41, 0xac, 0x4a, 0x5d, 0x71,
-	0xbb, 0xe8, 0x66, 0x32, 0x3b, 0x6d, 0x77, 0xfb, 0x95, 0x7d, 0x93, 0xba, 0x79, 0x79, 0x88, 0x76,
-	0x43, 0xe0, 0x6c, 0x9c, 0x08, 0x47, 0x45, 0x08, 0xe2, 0xb1, 0x66, 0x75, 0x06, 0xee, 0xe0, 0x06,
-	0xae, 0x99, 0x0c, 0x58, 0xbb, 0x1a, 0x9a, 0x91, 0x6a, 0x68, 0xff, 0x82, 0xf1, 0xba, 0x94, 0xc8,
-	0xd4, 0x24, 0xb3, 0x28, 0x08, 0x4e, 0xe9, 0xe6, 0x62, 0x4f, 0x9f, 0x67, 0x46, 0xf2, 0x5a, 0xcf,
-	0xfa, 0x3a, 0x02, 0x53, 0x3b, 0xbc, 0xb2, 0x8d, 0xab, 0xd5, 0x40, 0x76, 0x71, 0xc3, 0x65, 0xe6,
-	0x1d, 0xc4, 0x1a, 0xcd, 0x41, 0xdc, 0xc5, 0xac, 0x50, 0xc2, 0x75, 0xfd, 0xcf, 0x8c, 0xbb, 0x98,
-	0x6d, 0xe3, 0x3a, 0xfa, 0x08, 0x66, 0xea, 0x0d, 0x5a, 0xa7, 0x8c, 0x34, 0x3a, 0xff, 0x9d, 0xf8,
-	0x67, 0x26, 0x73, 0xd9, 0x5f, 0x9f, 0x67, 0x6c, 0xd7, 0xe3, 0x95, 0x66, 0xd1, 0x2e, 0xd1, 0x9a,
-	0xa3, 0x5b, 0xb9, 0xfa, 0x5c, 0x64, 0xe5, 0x03, 0x87, 0x1f, 0xd6, 0x09, 0xb3, 0xb7, 0xbb, 0x3f,
-	0x7c, 0x7e, 0xda, 0xf8, 0x32, 0x3f, 0xeb, 0x02, 0x24, 0x4a, 0x15, 0xec, 0xf9, 0x05, 0xaf, 0x2c,
-	0xbb, 0x54, 0x34, 0x1f, 0x97, 0xfb, 0xeb, 0x65, 0x6b, 0x03, 0x4e, 0xef, 0x30, 0xee, 0xd5, 0x30,
-	0x27, 0xbb, 0xb8, 0x9b, 0x82, 0x19, 0x88, 0xba, 0x58, 0x81, 0x8f, 0xe5, 0xc5, 0xd2, 0xfa, 0x25,
-	0x6a, 0xde, 0xb1, 0x81, 0x4b, 0x64, 0xaf, 0x6d, 0xee, 0xf9, 0x4f, 0x88, 0xd6, 0x98, 0xab, 0x33,
-	0xb5, 0x10, 0xcc, 0xd4, 0x2d, 0xe6, 0xee, 0xf0, 0x0a, 0x69, 0x90, 0x66, 0x6d, 0xaf, 0x9d, 0x17,
-	0x5a, 0xe8, 0x32, 0x4c, 0x72, 0x61, 0x5e, 0x28, 0x51, 0x7f, 0xdf, 0x73, 0xe5, 0x1d, 0x93, 0xd9,
-	0xb9, 0xa0, 0x95, 0x74, 0xbf, 0x2d, 0x8f, 0xf3, 0x49, 0xde, 0xdd, 0xa0, 0x2b, 0x30, 0x59, 0x6f,
-	0x90, 0x32, 0x29, 0x11, 0xc6, 0x68, 0x83, 0xcd, 0xc7, 0x64, 0xe1, 0x1c, 0x13, 0xb1, 0x47, 0x5d,
-	0x34, 0xca, 0x62, 0x95, 0x96, 0x0e, 0x4c, 0x4b, 0x1a, 0x93, 0x79, 0x48, 0x4a, 0x99, 0x6a, 0x48,
-	0x68, 0x19, 0x40, 0xa9, 0xc8, 0xdf, 0x42, 0xb5, 0xe3, 0x09, 0x29, 0x91, 0x8d, 0x7e, 0xdb, 0x1c,
-	0x8b, 0x99, 0x35, 0x1f, 0x97, 0xd0, 0x53, 0xb6, 0x1a, 0x68, 0xb6, 0x19, 0x68, 0xf6, 0x9e, 0x19,
-	0x68, 0xb9, 0x84, 0x28, 0x91, 0xc7, 0x3f, 0x64, 0x22, 0xda, 0x89, 0x38, 0x09, 0x7d, 0xe9, 0xc4,
-	0x9f, 0xf3, 0xd2, 0x13, 0x3d, 0x2f, 0x8d, 0x2c, 0x38, 0xa5, 0xe0, 0xd7, 0x70, 0xbb, 0x20, 0x1e,
-	0x17, 0x02, 0x19, 0xb8, 0x85, 0xdb, 0xbb, 0x98, 0xbd, 0x1d, 0x4b, 0x8c, 0xce, 0x44, 0xf3, 0x09,
-	0xde, 0x2e, 0x78, 0x7e, 0x99, 0xb4, 0xad, 0xf3, 0xba, 0x8f, 0x75, 0xde, 0xbc, 0xdb, 0x64, 0xca,
-	0x98, 0x63, 0x53, 0xdc, 0x62, 0x6d, 0x7d, 0x19, 0xd5, 0xa3, 0x5b, 0x2a, 0xe7, 0x84, 0xd7, 0x40,
-	0x8d, 0xf0, 0xb6, 0xf9, 0xd5, 0x8f, 0xab, 0x11, 0xde, 0x66, 0xaf, 0x55, 0x23, 0x7f, 0x3d, 0xf2,
-	0xc9, 0x8f, 0x6c, 0x5d, 0xd4, 0x1c, 0x29, 0xf8, 0x4e, 0xc7, 0xbc, 0xeb, 0x99, 0xce, 0x98, 0x66,
-	0xe4, 0x1a, 0x31, 0xdd, 0xde, 0x7a, 0x14, 0xe9, 0xcc, 0x60, 0x2d, 0xd7, 0x3e, 0xfe, 0x0d, 0x09,
-	0xd1, 0x99, 0x0b, 0xfb, 0x44, 0x8f, 0xb9, 0xdc, 0xc2, 0xf7, 0xcf, 0x33, 0x67, 0xd4, 0x15, 0x59,
-	0xf9, 0xc0, 0xf6, 0xa8, 0x53, 0xc3, 0xbc, 0x62, 0x5f, 0xf7, 0xb9, 0x98, 0xcf, 0xd2, 0x1a, 0xbd,
-	0x01, 0x53, 0xc6, 0xaa, 0xd0, 0xf4, 0xbd, 0xa2, 0x1e, 0xd1, 0xc7, 0xd9, 0x4e, 0x6a, 0xdb, 0x77,
-	0x85, 0xba, 0x75, 0x05, 0x16, 0x25, 0x9c, 0x6b, 0x4d, 0x7f, 0x8f, 0x1e, 0x10, 0xff, 0x16, 0xae,
-	0xd7, 0x3d, 0xdf, 0x35, 0x25, 0x38, 0x0b, 0x63, 0x5c, 0x88, 0xcd, 0xe4, 0x95, 0x9b, 0xc0, 0x98,
-	0xfa, 0x50, 0xf3, 0xac, 0x01, 0x73, 0x7d, 0xab, 0x2d, 0x98, 0xd8, 0x6f, 0xfa, 0x85, 0xae, 0x8f,
-	0x64, 0x76, 0x36, 0x58, 0x92, 0xc6, 0x2e, 0x9f, 0xd8, 0xd7, 0xab, 0xae, 0xf3, 0xec, 0x57, 0x93,
-	0x30, 0x26, 0xbd, 0xa3, 0x87, 0x11, 0x80, 0x2e, 0x37, 0x45, 0x56, 0xd0, 0x45, 0x38, 0xef, 0x4d,
-	0xad, 0x1d, 0xab, 0xa3, 0xe0, 0x59, 0x17, 0x3e, 0xfd, 0xf6, 0xa7, 0x2f, 0x46, 0xd7, 0xd1, 0xdf,
-	0x1d, 0x91, 0x8c, 0x46, 0xb3, 0x43, 0xe1, 0x05, 0x07, 0x55, 0xba, 0xce, 0x03, 0x5d, 0x8a, 0x47,
-	0xe8, 0x49, 0x04, 0x66, 0xfa, 0x29, 0x20, 0xda, 0x1c, 0x88, 0x33, 0x84, 0x73, 0xa6, 0xce, 0xfd,
-	0x0e, 0x4d, 0x8d, 0xeb, 0xbf, 0x12, 0xd7, 0x16, 0x72, 0xfa, 0x70, 0xb5, 0x8c, 0x41, 0x17, 0x5d,
-	0x90, 0xc6, 0x1e, 0xa1, 0xfb, 0x10, 0xcf, 0x19, 0xea, 0x36, 0x10, 0xae, 0x97, 0x31, 0xa6, 0x56,
-	0x86, 0x2b, 0x68, 0x18, 0xe7, 0x24, 0x8c, 0x35, 0xb4, 0xda, 0x07, 0x43, 0xf3, 0x3f, 0x16, 0xc8,
-	0xcd, 0x27, 0x10, 0xd7, 0xac, 0x2d, 0x24, 0x70, 0x2f, 0x39, 0x0c, 0x09, 0xdc, 0x47, 0xf8, 0x2c,
-	0x5b, 0x06, 0xde, 0x44, 0xeb, 0x7d, 0x81, 0x99, 0xd2, 0xeb, 0xc6, 0x75, 0x1e, 0x1c, 0x90, 0xc3,
-	0x23, 0x74, 0x00, 0x31, 0xc1, 0xe6, 0xd0, 0xd2, 0x80, 0xe7, 0x00, 0x39, 0x4c, 0x2d, 0x0f, 0x39,
-	0xd5, 0x41, 0xd7, 0x65, 0xd0, 0x15, 0x94, 0xee, 0x0b, 0x2a, 0xb8, 0x60, 0xf0, 0xaa, 0x15, 0x18,
-	0x57, 0x6c, 0x06, 0xa5, 0x07, 0x1c, 0xf6, 0x10, 0xa5, 0x54, 0x66, 0xe8, 0xb9, 0x0e, 0xb9, 0x2c,
-	0x43, 0xce, 0xa1, 0x33, 0x7d, 0x21, 0x15, 0x3f, 0x42, 0x1e, 0xc4, 0x35, 0x3d, 0x42, 0xa9, 0xa0,
-	0xab, 0x5e, 0xce, 0x94, 0x5a, 0x1d, 0x3e, 0x1a, 0x4c, 0xa0, 0x8c, 0x0c, 0xb4, 0x80, 0xe6, 0x42,
-	0x0a, 0xbd, 0x24, 0xfc, 0x53, 0x48, 0x06, 0x08, 0xcd, 0xb1, 0xe1, 0x7a, 0x6e, 0x15, 0xc2, 0x82,
-	0xac, 0x35, 0x19, 0x6c, 0x19, 0x2d, 0xf6, 0x07, 0xd3, 0xba, 0xa2, 0xc3, 0xa2, 0x1a, 0xc4, 0xf5,
-	0x78, 0x0c, 0x29, 0x98, 0x5e, 0xb2, 0x14, 0x52, 0x30, 0x7d, 0x93, 0x75, 0xe8, 0xfd, 0xd4, 0x48,
-	0xe4, 0x6d, 0x74, 0x08, 0xd0, 0x6d, 0xdc, 0x21, 0x0d, 0x64, 0x60, 0xfa, 0x86, 0x34, 0x90, 0xc1,
-	0xce, 0x6f, 0x59, 0x32, 0xee, 0x12, 0x4a, 0x85, 0xc6, 0x95, 0xe3, 0x03, 0xdd, 0x83, 0x09, 0x35,
-	0x79, 0x45, 0x9e, 0xff, 0x80, 0xbb, 0xae, 0xca, 0x98, 0x8b, 0x68, 0x21, 0x34, 0xa6, 0x7c, 0xcd,
-	0x9a, 0x68, 0x03, 0x6a, 0x42, 0x84, 0xb5, 0x81, 0xe0, 0x44, 0x0a, 0x6d, 0x03, 0x3d, 0xa3, 0x69,
-	0x68, 0x72, 0xcd, 0xe4, 0x41, 0x8f, 0x22, 0x30, 0xdd, 0x37, 0x01, 0xd0, 0xc6, 0x80, 0xdb, 0xf0,
-	0x11, 0x93, 0xda, 0x3c, 0x59, 0x51, 0xe3, 0xd8, 0x90, 0x38, 0x56, 0x51, 0xa6, 0x0f, 0xc7, 0x7e,
-	0xd3, 0x97, 0x03, 0xc6, 0x79, 0x20, 0x3f, 0x47, 0xb9, 0x37, 0x9f, 0xbe, 0x48, 0x47, 0x9e, 0xbd,
-	0x48, 0x47, 0x7e, 0x7c, 0x91, 0x8e, 0x3c, 0x7e, 0x99, 0x1e, 0x79, 0xf6, 0x32, 0x3d, 0xf2, 0xdd,
-	0xcb, 0xf4, 0xc8, 0x07, 0xeb, 0x01, 0x12, 0x71, 0x5b, 0x3a, 0xd9, 0x16, 0x14, 0xc0, 0x38, 0x6c,
-	0x65, 0x9d, 0xb6, 0xf0, 0x5a, 0x1c, 0x97, 0x9c, 0xe5, 0xd2, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0x31, 0x27, 0xb5, 0x28, 0x12, 0x00, 0x00,
+	// 2188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xea, 0x8b, 0xd4, 0xa3, 0x2c, 0xd1, 0x23, 0xd9, 0x96, 0xd6, 0x92, 0x48, 0xaf, 0x5a,
+	0x49, 0x76, 0x13, 0xd2, 0x62, 0x8a, 0x36, 0x71, 0x1b, 0xb4, 0x16, 0x2d, 0xbb, 0x6a, 0x6c, 0xd9,
+	0x59, 0xcb, 0x29, 0xd0, 0xa2, 0x58, 0x0c, 0xc9, 0xd1, 0x72, 0x21, 0xee, 0x87, 0x77, 0x86, 0x0c,
+	0x5d, 0xc7, 0x97, 0xe6, 0xd2, 0xa2, 0x08, 0x10, 0x20, 0xb7, 0x9e, 0x7c, 0xea, 0x1f, 0x51, 0xf4,
+	0x56, 0x14, 0x48, 0x4f, 0x35, 0xd0, 0x4b, 0xd1, 0x83, 0x5b, 0xd8, 0x3d, 0xf4, 0xdc, 0x63, 0x4f,
+	0xc5, 0x7c, 0x2c, 0xb9, 0x4b, 0x2e, 0x45, 0xbb, 0x49, 0x81, 0x1e, 0x72, 0xda, 0x9d, 0x37, 0x6f,
+	0xde, 0xc7, 0x6f, 0xde, 0xbc, 0x79, 0x6f, 0xe0, 0x3c, 0x61, 0xcd, 0x32, 0xe9, 0xb8, 0xe5, 0xce,
+	0x6e, 0xf9, 0x61, 0x9b, 0x84, 0x8f, 0x4a, 0x41, 0xe8, 0x33, 0x1f, 0x01, 0x61, 0xcd, 0x12, 0xe9,
+	0xb8, 0xa5, 0xce, 0xae, 0x7e, 0xa5, 0xee, 0x53, 0xd7, 0xa7, 0xe5, 0x1a, 0xa6, 0x44, 0x32, 0x95,
+	0x3b, 0xbb, 0x35, 0xc2, 0xf0, 0x6e, 0x39, 0xc0, 0xb6, 0xe3, 0x61, 0xe6, 0xf8, 0x9e, 0x5c, 0xa7,
+	0x2f, 0xc7, 0xe4, 0xf1, 0xe5, 0x92, 0xba, 0x14, 0xa3, 0xb2, 0x6e, 0xc4, 0x6a, 0xfb, 0xb6, 0x2f,
+	0x7e, 0xcb, 0xfc, 0x4f, 0x51, 0xd7, 0x6c, 0xdf, 0xb7, 0x5b, 0xa4, 0x8c, 0x03, 0xa7, 0x8c, 0x3d,
+	0xcf, 0x67, 0x42, 0x3a, 0x55, 0xb3, 0x05, 0x35, 0x2b, 0x46, 0xb5, 0xf6, 0x71, 0x99, 0x39, 0x2e,
+	0xa1, 0x0c, 0xbb, 0x81, 0x64, 0x30, 0xbe, 0x0b, 0xe7, 0xdf, 0xe7, 0x16, 0xee, 0xb3, 0xe6, 0xf5,
+	0x7a, 0xdd, 0x6f, 0x7b, 0xcc, 0x24, 0x0f, 0xdb, 0x84, 0x32, 0xb4, 0x02, 0x19, 0xdc, 0x68, 0x84,
+	0x84, 0xd2, 0x15, 0xad, 0xa8, 0xed, 0xcc, 0x99, 0xd1, 0xf0, 0x5a, 0xf6, 0x17, 0x4f, 0x0b, 0x13,
+	0xff, 0x7c, 0x5a, 0x98, 0x30, 0xfe, 0xa4, 0xc1, 0x85, 0xa1, 0xe5, 0x34, 0xf0, 0x3d, 0x4a, 0xf8,
+	0xfa, 0x1a, 0x6e, 0x61, 0xaf, 0x4e, 0xa2, 0xf5, 0x6a, 0x88, 0x0a, 0x90, 0x53, 0xbf, 0xd6, 0x87,
+	0xc4, 0x59, 0x99, 0x14, 0xb3, 0xa0, 0x48, 0x3f, 0x22, 0x0e, 0xba, 0x08, 0x73, 0x75, 0xbf, 0x41,
+	0xac, 0x26, 0xa6, 0xcd, 0x95, 0x29, 0x31, 0x9d, 0xe5, 0x84, 0x1f, 0x60, 0xda, 0x44, 0xcb, 0x30,
+	0xe3, 0xf9, 0x5c, 0xea, 0x74, 0x51, 0xdb, 0x99, 0x36, 0xe5, 0x80, 0xcb, 0x24, 0xac, 0x69, 0x45,
+	0x16, 0xcf, 0x48, 0x99, 0x84, 0x35, 0xaf, 0x4b, 0x0a, 0xfa, 0x3a, 0x2c, 0xd4, 0x48, 0xbd, 0xf9,
+	0x56, 0xa5, 0xc7, 0x33, 0x2b, 0x78, 0xce, 0x48, 0xaa, 0x62, 0x33, 0xde, 0x83, 0x35, 0xe1, 0xd0,
+	0x07, 0xb8, 0xe5, 0x34, 0x30, 0xf3, 0xc3, 0x01, 0x54, 0x2e, 0xc1, 0x7c, 0xdd, 0xf7, 0xa8, 0x95,
+	0x84, 0x26, 0xc7, 0x69, 0xd7, 0x87, 0xe0, 0xf9, 0x95, 0x06, 0xeb, 0x23, 0xa4, 0x29, 0x90, 0xb6,
+	0x61, 0x11, 0x4b, 0xd2, 0x80, 0xc4, 0x05, 0x45, 0x8e, 0xcc, 0xd7, 0x21, 0x4b, 0xb9, 0x09, 0xdc,
+	0xf1, 0x49, 0xe1, 0x78, 0x6f, 0xcc, 0x5d, 0x8b, 0x84, 0x78, 0x6d, 0xb7, 0x46, 0x42, 0x81, 0xd9,
+	0xb4, 0x79, 0x46, 0x51, 0x0f, 0x05, 0xd1, 0x78, 0x07, 0x96, 0x84, 0x31, 0x7b, 0x12, 0xe8, 0xd7,
+	0xd9, 0xe7, 0xf7, 0x61, 0x39, 0xb9, 0xf4, 0x0b, 0xef, 0xb1, 0xf1, 0x11, 0xe8, 0x42, 0xe4, 0x3d,
+	0xe2, 0x35, 0x1c, 0xcf, 0x7e, 0xd5, 0xe0, 0x43, 0xd7, 0x20, 0x17, 0xc8, 0x25, 0x16, 0xeb, 0xd2,
+	0x95, 0xc9, 0xe2, 0xd4, 0x4e, 0xae, 0xb2, 0x5a, 0xea, 0x1f, 0xbf, 0xd2, 0x1d, 0x6a, 0xef, 0xb3,
+	0x26, 0x09, 0x49, 0xdb, 0x3d, 0xea, 0x9a, 0xa0, 0xb8, 0x8f, 0xba, 0x71, 0x87, 0xda, 0x70, 0x31,
+	0x55, 0xbb, 0xf2, 0x6b, 0xc0, 0x7a, 0x6d, 0x28, 0x42, 0x7b, 0x41, 0x38, 0x39, 0x10, 0x84, 0x38,
+	0x08, 0x5a, 0x0e, 0x69, 0x08, 0xdb, 0xe4, 0x2e, 0x80, 0x22, 0x1d, 0x75, 0x79, 0x74, 0xc9, 0x2d,
+	0xb8, 0xcf, 0xfc, 0x10, 0xdb, 0xe3, 0xb7, 0x00, 0xe5, 0x61, 0xea, 0x84, 0x3c, 0x52, 0xf0, 0xf1,
+	0xdf, 0x98, 0x0f, 0x6f, 0xc0, 0x72, 0x52, 0x98, 0x32, 0x7e, 0x19, 0x66, 0x3a, 0xb8, 0xd5, 0x8e,
+	0xb6, 0x44, 0x0e, 0x8c, 0x6f, 0x41, 0x5e, 0x70, 0x57, 0xfd, 0xc6, 0x6b, 0x6d, 0xfd, 0x36, 0x9c,
+	0x8d, 0xad, 0x53, 0x2a, 0x10, 0x4c, 0xf3, 0xf3, 0x28, 0x56, 0xcd, 0x9b, 0xe2, 0xdf, 0xf8, 0x19,
+	0x20, 0xc1, 0x78, 0xd4, 0xbd, 0xed, 0xdb, 0x34, 0x52, 0x81, 0x60, 0x5a, 0x9c, 0x62, 0x29, 0x5f,
+	0xfc, 0xa3, 0x9b, 0x00, 0xfd, 0x3c, 0x28, 0x7c, 0xcb, 0x55, 0xb6, 0x4a, 0x32, 0x69, 0x96, 0x78,
+	0xd2, 0x2c, 0xc9, 0xcc, 0xaa, 0x92, 0x66, 0xe9, 0x5e, 0x1f, 0x2a, 0x33, 0xb6, 0x32, 0x66, 0xe4,
+	0xc7, 0x1a, 0x2c, 0x25, 0x94, 0x2b, 0x3b, 0x37, 0x61, 0xba, 0xe5, 0xdb, 0xdc, 0x3b, 0x1e, 0x25,
+	0x8b, 0xf1, 0x28, 0xb9, 0xed, 0xdb, 0xa6, 0x98, 0x44, 0xb7, 0x52, 0xcc, 0xd9, 0x1e, 0x6b, 0x8e,
+	0xd4, 0x10, 0xb7, 0xc7, 0x58, 0x56, 0x08, 0xdc, 0xc3, 0x21, 0x76, 0x23, 0x04, 0x8c, 0x5b, 0xb0,
+	0x94, 0xa0, 0x2a, 0xd3, 0xae, 0xc2, 0x6c, 0x20, 0x28, 0x02, 0x9a, 0x5c, 0x05, 0xc5, 0x8d, 0x93,
+	0xbc, 0x7b, 0xd3, 0x9f, 0x3f, 0x2f, 0x4c, 0x98, 0x8a, 0xcf, 0xf8, 0xc3, 0x24, 0x2c, 0xec, 0xb3,
+	0x66, 0x15, 0xb7, 0x5a, 0x31, 0x74, 0x71, 0x68, 0xd3, 0x68, 0x1f, 0xf8, 0x3f, 0xba, 0x00, 0x19,
+	0x1b, 0x53, 0xab, 0x8e, 0x03, 0x15, 0x9c, 0xb3, 0x36, 0xa6, 0x55, 0x1c, 0xa0, 0x9f, 0x42, 0x3e,
+	0x08, 0xfd, 0xc0, 0xa7, 0x24, 0xec, 0x25, 0x1b, 0x1e, 0xa2, 0xf3, 0x7b, 0x95, 0x7f, 0x3f, 0x2f,
+	0x94, 0x6c, 0x87, 0x35, 0xdb, 0xb5, 0x52, 0xdd, 0x77, 0xcb, 0xea, 0xfe, 0x92, 0x9f, 0x37, 0x69,
+	0xe3, 0xa4, 0xcc, 0x1e, 0x05, 0x84, 0x96, 0xaa, 0xfd, 0x2c, 0x67, 0x2e, 0x46, 0xb2, 0x14, 0x01,
+	0xad, 0x42, 0xb6, 0xde, 0xc4, 0x8e, 0x67, 0x39, 0x0d, 0x91, 0x9a, 0xa7, 0xcc, 0x8c, 0x18, 0x1f,
+	0x34, 0x78, 0x96, 0xa3, 0x0c, 0x33, 0x62, 0xf9, 0x1d, 0x12, 0x86, 0x4e, 0x83, 0xc8, 0x04, 0x3d,
+	0x6f, 0x2e, 0x08, 0xf2, 0xdd, 0x88, 0xca, 0x19, 0x6b, 0x2d, 0xbf, 0x7e, 0x12, 0x63, 0x9c, 0x95,
+	0x8c, 0x82, 0xdc, 0x67, 0x1c, 0xc8, 0x02, 0x99, 0xd7, 0xc8, 0x02, 0xc6, 0x6f, 0x35, 0x38, 0x7b,
+	0xdf, 0x71, 0xdb, 0x2d, 0xcc, 0xc8, 0x07, 0xbb, 0x31, 0x28, 0xfd, 0x80, 0xf5, 0xa0, 0xe4, 0xff,
+	0xff, 0x87, 0x50, 0x1a, 0x87, 0x80, 0xe2, 0xb6, 0xab, 0x60, 0x7a, 0x1b, 0x66, 0x05, 0x40, 0x51,
+	0xa4, 0xeb, 0x71, 0x24, 0x22, 0xfe, 0xc6, 0x1e, 0x67, 0x89, 0x82, 0x4a, 0xf2, 0x1b, 0xbf, 0x9e,
+	0x84, 0x85, 0x24, 0x03, 0x3a, 0x0f, 0xb3, 0xea, 0x1a, 0xd1, 0xa4, 0xd3, 0x72, 0x84, 0xd6, 0x60,
+	0xae, 0x57, 0x3d, 0x28, 0x3c, 0xfa, 0x04, 0x7e, 0x67, 0x73, 0xac, 0x5a, 0x8e, 0xeb, 0x30, 0x95,
+	0xf9, 0xb2, 0x36, 0xa6, 0xb7, 0xf9, 0x98, 0x3b, 0xc4, 0x27, 0xdb, 0x94, 0x34, 0xd4, 0xb5, 0xcd,
+	0x81, 0x7d, 0x40, 0x49, 0x03, 0x6d, 0xc2, 0x99, 0x63, 0x42, 0xac, 0x90, 0xd4, 0x9d, 0xc0, 0x21,
+	0x1e, 0x53, 0x57, 0xf7, 0xfc, 0x31, 0x21, 0x66, 0x44, 0x43, 0x6f, 0x43, 0x96, 0x1f, 0x44, 0xeb,
+	0x98, 0x10, 0x79, 0x6d, 0xef, 0xad, 0x73, 0x2f, 0xfe, 0xfa, 0xbc, 0x70, 0x4e, 0x22, 0x4b, 0x1b,
+	0x27, 0x25, 0xc7, 0x2f, 0xbb, 0x98, 0x35, 0x4b, 0x07, 0x1e, 0xe3, 0xf7, 0x10, 0x25, 0x37, 0x09,
+	0x41, 0xdf, 0x81, 0x99, 0x3a, 0x6e, 0xb5, 0xa2, 0x10, 0x29, 0xa4, 0x02, 0x23, 0x8f, 0x14, 0x6d,
+	0xb7, 0x98, 0x42, 0x47, 0xae, 0x31, 0x7e, 0xa7, 0xc1, 0x52, 0x0a, 0x13, 0xcf, 0xf3, 0x21, 0x61,
+	0xed, 0xd0, 0xb3, 0x1a, 0x98, 0x61, 0x15, 0x32, 0x20, 0x49, 0x37, 0x30, 0xc3, 0xe8, 0xb2, 0xca,
+	0x3b, 0x93, 0xa9, 0x79, 0x47, 0x29, 0x91, 0xd9, 0x27, 0x0e, 0xcd, 0x54, 0x12, 0x9a, 0x55, 0xc8,
+	0x76, 0x5c, 0x8b, 0x84, 0xa1, 0x1f, 0x0a, 0xd4, 0xe6, 0xcc, 0x4c, 0xc7, 0xdd, 0xe7, 0x43, 0x8e,
+	0x5a, 0x48, 0x3a, 0x24, 0x64, 0x56, 0x48, 0x30, 0xf5, 0xbd, 0x08, 0x35, 0x49, 0x34, 0x05, 0xcd,
+	0xd8, 0x86, 0xa5, 0x7d, 0xca, 0x1c, 0x17, 0x33, 0x72, 0x0b, 0xf7, 0x33, 0x4f, 0x1e, 0xa6, 0x6c,
+	0x4c, 0xd5, 0xe6, 0xf2, 0x5f, 0xe3, 0x5f, 0x53, 0x51, 0xfa, 0x0c, 0x71, 0x9d, 0x1c, 0x75, 0xa3,
+	0x33, 0xf1, 0x0d, 0x98, 0x72, 0xa9, 0xad, 0x12, 0xd4, 0x29, 0xa7, 0x8b, 0x73, 0xa1, 0x6b, 0x30,
+	0xcf, 0xf8, 0x72, 0xab, 0xee, 0x7b, 0xc7, 0x8e, 0x2d, 0x9c, 0xc9, 0x55, 0x2e, 0xc4, 0x57, 0x09,
+	0xf1, 0x55, 0x31, 0x6d, 0xe6, 0x58, 0x7f, 0x80, 0xde, 0x85, 0xf9, 0x20, 0x24, 0x0d, 0x52, 0x27,
+	0x94, 0xfa, 0x21, 0x5d, 0x99, 0x1e, 0x77, 0x9e, 0x13, 0xec, 0xbc, 0x28, 0x93, 0x69, 0x43, 0xc5,
+	0xed, 0x8c, 0x38, 0x33, 0x39, 0x41, 0x93, 0xc5, 0x0f, 0x5a, 0x07, 0x90, 0x2c, 0xe2, 0x36, 0x92,
+	0xa5, 0xdf, 0x9c, 0xa0, 0x88, 0xa2, 0xb2, 0x1a, 0x4d, 0xf3, 0x80, 0x5e, 0xc9, 0x08, 0xd3, 0xf5,
+	0x92, 0x2c, 0x9e, 0x4b, 0x51, 0xf1, 0x5c, 0x3a, 0x8a, 0xa2, 0x7d, 0x2f, 0xcb, 0x77, 0xf0, 0xd3,
+	0xbf, 0x15, 0x34, 0x25, 0x84, 0xcf, 0xa4, 0x66, 0x85, 0xec, 0xff, 0x26, 0x2b, 0xcc, 0x25, 0x13,
+	0xac, 0x01, 0x67, 0xa4, 0xf9, 0x2e, 0xee, 0x5a, 0x7c, 0x73, 0x21, 0x86, 0xc0, 0x1d, 0xdc, 0xbd,
+	0x85, 0xe9, 0x0f, 0xa7, 0xb3, 0x93, 0xf9, 0x29, 0x33, 0xcb, 0xba, 0x96, 0xe3, 0x35, 0x48, 0xd7,
+	0xb8, 0xa2, 0xca, 0x87, 0xde, 0x9e, 0xf7, 0xef, 0xf6, 0x58, 0x54, 0x8b, 0x7f, 0xe3, 0x37, 0x53,
+	0x70, 0xbe, 0xcf, 0x2c, 0xd2, 0x44, 0x2c, 0x46, 0x58, 0x37, 0xca, 0x3b, 0xa7, 0xc5, 0x08, 0xeb,
+	0xd2, 0x2f, 0x14, 0x23, 0x5f, 0x6d, 0xf2, 0xf8, 0x4d, 0x36, 0xde, 0x54, 0xfd, 0x58, 0x7c, 0x9f,
+	0x4e, 0xd9, 0xd7, 0x73, 0xbd, 0x96, 0x40, 0x64, 0xcb, 0xa8, 0x64, 0xf9, 0x44, 0x83, 0xe5, 0x24,
+	0x5d, 0xc9, 0xf8, 0x66, 0x2c, 0x0f, 0x8b, 0x8a, 0x6e, 0x6f, 0xf5, 0x15, 0x72, 0xf0, 0xf7, 0x60,
+	0x21, 0x5a, 0x65, 0xb5, 0x3d, 0xa7, 0xa6, 0xda, 0x81, 0xd3, 0xd6, 0xce, 0xab, 0xb5, 0x0f, 0x38,
+	0xbb, 0xf1, 0xae, 0xaa, 0xd6, 0x6f, 0xb6, 0xbd, 0x23, 0xff, 0x84, 0x78, 0x77, 0x70, 0x10, 0x38,
+	0x9e, 0x1d, 0x85, 0xe0, 0x32, 0xcc, 0x30, 0x4e, 0x8e, 0x0a, 0x5e, 0x31, 0x88, 0x55, 0x87, 0x3f,
+	0x81, 0xb5, 0xf4, 0xe5, 0xca, 0xab, 0x5d, 0x98, 0x3b, 0x6e, 0x7b, 0x56, 0x5f, 0x46, 0xae, 0xb2,
+	0x1c, 0x0f, 0xc9, 0x68, 0x9d, 0x99, 0x3d, 0x56, 0x7f, 0x31, 0xe1, 0xcf, 0xb4, 0x74, 0xe9, 0xbd,
+	0x0a, 0x38, 0x59, 0xed, 0x6a, 0xff, 0x6d, 0xb5, 0x8b, 0x2a, 0x30, 0xeb, 0x87, 0x8e, 0xed, 0xc8,
+	0x12, 0x75, 0x21, 0x79, 0xc7, 0x47, 0xca, 0xef, 0x0a, 0x0e, 0x53, 0x71, 0xa2, 0x2b, 0x70, 0xb6,
+	0x86, 0xbd, 0x13, 0xab, 0x41, 0x3c, 0xdf, 0xb5, 0x82, 0x90, 0x1c, 0x3b, 0x5d, 0xd5, 0x50, 0x2f,
+	0xf2, 0x89, 0x1b, 0x9c, 0x7e, 0x4f, 0x90, 0x63, 0x2e, 0xfd, 0x3e, 0x6a, 0x5b, 0x87, 0x5d, 0x52,
+	0x88, 0xbd, 0x03, 0xd0, 0x43, 0x2c, 0x3a, 0xfb, 0xa9, 0x90, 0xa9, 0xab, 0x6e, 0x2e, 0x02, 0xee,
+	0xcb, 0xab, 0xb6, 0xf9, 0xae, 0x8b, 0xa6, 0x4d, 0xdd, 0x9a, 0x72, 0xd0, 0xf7, 0xe2, 0x8a, 0x03,
+	0x0b, 0x49, 0x54, 0x50, 0x11, 0xd6, 0x6e, 0x3e, 0x38, 0xb4, 0x8e, 0xee, 0xbe, 0xb7, 0x7f, 0x68,
+	0xdd, 0x35, 0x0f, 0x6e, 0x1d, 0x1c, 0x5a, 0x0f, 0x0e, 0xef, 0xdf, 0xdb, 0xaf, 0x1e, 0xdc, 0x3c,
+	0xd8, 0xbf, 0x91, 0x9f, 0x40, 0xab, 0x70, 0x6e, 0x88, 0xa3, 0x7a, 0xf7, 0xe0, 0x30, 0xaf, 0x21,
+	0x1d, 0xce, 0x0f, 0x4d, 0xed, 0x9b, 0xd5, 0xca, 0xd5, 0xfc, 0x64, 0xe5, 0x8f, 0x8b, 0x30, 0x23,
+	0x00, 0x43, 0x1f, 0x6b, 0x00, 0xfd, 0xb7, 0x10, 0x64, 0xc4, 0x31, 0x49, 0x7f, 0x67, 0xd1, 0x37,
+	0x4f, 0xe5, 0x91, 0x8e, 0x1b, 0x6f, 0xfc, 0xfc, 0xcf, 0xff, 0xf8, 0x6c, 0x72, 0x0b, 0x7d, 0xad,
+	0xcc, 0x0f, 0x44, 0xd8, 0xee, 0x3d, 0x19, 0xf1, 0x37, 0x0f, 0xc9, 0x5b, 0x7e, 0xac, 0xd2, 0xd1,
+	0x13, 0xf4, 0x54, 0x83, 0xfc, 0xe0, 0x93, 0x03, 0xda, 0x19, 0xd2, 0x33, 0xe2, 0x8d, 0x43, 0xbf,
+	0xfc, 0x0a, 0x9c, 0xca, 0xae, 0x6f, 0x0b, 0xbb, 0x76, 0x51, 0x79, 0xc0, 0xae, 0x4e, 0xb4, 0xa0,
+	0x6f, 0x5d, 0xfc, 0xd9, 0xe4, 0x09, 0xfa, 0x10, 0x32, 0x7b, 0xd1, 0x53, 0xc1, 0x90, 0xba, 0xe4,
+	0x0b, 0x85, 0x5e, 0x1c, 0xcd, 0xa0, 0xcc, 0xb8, 0x2c, 0xcc, 0xd8, 0x44, 0x97, 0x06, 0xcc, 0x50,
+	0x1d, 0x3b, 0x8d, 0x61, 0xf3, 0x99, 0x06, 0x0b, 0xc9, 0xae, 0x1f, 0x6d, 0x0d, 0xc9, 0x4f, 0x7d,
+	0x94, 0xd0, 0xb7, 0xc7, 0xf2, 0x29, 0x73, 0xae, 0x0a, 0x73, 0xae, 0xa0, 0x9d, 0x01, 0x73, 0xa2,
+	0x96, 0x65, 0x78, 0xc7, 0x3e, 0x82, 0x8c, 0x6a, 0xe3, 0x53, 0xe0, 0x48, 0xbe, 0x16, 0xe8, 0xc5,
+	0xd1, 0x0c, 0x4a, 0x7f, 0x49, 0xe8, 0xdf, 0x41, 0x5b, 0x03, 0xfa, 0xa9, 0xe4, 0xeb, 0xeb, 0x2d,
+	0x3f, 0x3e, 0x21, 0x8f, 0x9e, 0xa0, 0x13, 0x98, 0xe6, 0xed, 0x3d, 0x5a, 0x1b, 0x92, 0x1c, 0x7b,
+	0x2d, 0xd0, 0xd7, 0x47, 0xcc, 0x2a, 0xa5, 0x5b, 0x42, 0x69, 0x11, 0x6d, 0x0c, 0x28, 0xe5, 0x8f,
+	0x03, 0xf1, 0x0d, 0x68, 0xc2, 0xac, 0x6c, 0x6f, 0xd1, 0xc6, 0x30, 0x9e, 0xf1, 0xce, 0x59, 0x2f,
+	0x8c, 0x9c, 0x57, 0x2a, 0xd7, 0x85, 0xca, 0x0b, 0xe8, 0xdc, 0x20, 0xce, 0x52, 0xbe, 0x03, 0x19,
+	0xd5, 0x2f, 0xa3, 0x44, 0xb2, 0x4c, 0x36, 0xd1, 0xfa, 0xa5, 0xd1, 0x45, 0x4b, 0xa4, 0xa8, 0x20,
+	0x14, 0xad, 0xa2, 0x0b, 0x29, 0xc7, 0x8f, 0xb7, 0x0a, 0xc8, 0x87, 0x5c, 0xac, 0xd4, 0x3e, 0x55,
+	0x5d, 0xc2, 0xab, 0x94, 0xfa, 0xdc, 0xd8, 0x14, 0xca, 0xd6, 0xd1, 0xc5, 0x41, 0x65, 0x8a, 0x97,
+	0xdf, 0xfd, 0xc8, 0x07, 0xe8, 0xf7, 0x81, 0x68, 0x3d, 0xad, 0xad, 0xe9, 0xf5, 0xb6, 0xfa, 0xc6,
+	0xa8, 0x69, 0xa5, 0xd1, 0x10, 0x1a, 0xd7, 0x90, 0x3e, 0x18, 0x2f, 0x8a, 0xd5, 0xea, 0xec, 0x22,
+	0x17, 0x32, 0xaa, 0x52, 0x4c, 0x89, 0xd0, 0x64, 0xdf, 0xa0, 0x17, 0x47, 0x33, 0x8c, 0x01, 0x54,
+	0x56, 0x87, 0xac, 0x8b, 0x1e, 0x01, 0xf4, 0x6b, 0x98, 0x94, 0x3c, 0x3a, 0x54, 0x88, 0xea, 0x9b,
+	0xa7, 0xf2, 0x8c, 0xf1, 0x54, 0xea, 0x15, 0x95, 0x14, 0x7a, 0x08, 0x73, 0xb2, 0x08, 0xe5, 0x1b,
+	0xfb, 0x25, 0xf8, 0x7a, 0x49, 0xe8, 0xbc, 0x88, 0x56, 0x53, 0x75, 0x8a, 0xf0, 0x71, 0x79, 0x36,
+	0x94, 0xc5, 0x52, 0x5a, 0x36, 0x8c, 0x17, 0x67, 0x7a, 0x71, 0x34, 0xc3, 0x18, 0x70, 0xa3, 0x22,
+	0x0c, 0x7d, 0xa2, 0xc1, 0xe2, 0xc0, 0xdd, 0x8e, 0x86, 0x93, 0x5b, 0x7a, 0xb5, 0xa5, 0xef, 0x8c,
+	0x67, 0x54, 0x76, 0x6c, 0x0b, 0x3b, 0x2e, 0xa1, 0xc2, 0x80, 0x1d, 0xc7, 0x6d, 0x4f, 0x54, 0x0e,
+	0xe5, 0xc7, 0xe2, 0xf3, 0x04, 0xfd, 0x52, 0x83, 0xfc, 0x80, 0x10, 0x8a, 0xc6, 0xea, 0xa1, 0xa3,
+	0xef, 0xab, 0x51, 0x85, 0x8b, 0x51, 0x14, 0x26, 0xe9, 0x68, 0x65, 0x84, 0x49, 0x74, 0xef, 0xfb,
+	0x9f, 0xbf, 0xd8, 0xd0, 0x9e, 0xbd, 0xd8, 0xd0, 0xfe, 0xfe, 0x62, 0x43, 0xfb, 0xf4, 0xe5, 0xc6,
+	0xc4, 0xb3, 0x97, 0x1b, 0x13, 0x7f, 0x79, 0xb9, 0x31, 0xf1, 0xe3, 0xad, 0x58, 0x6d, 0x7f, 0x28,
+	0x56, 0x57, 0x79, 0x65, 0x1e, 0x49, 0xea, 0x54, 0xca, 0x5d, 0x2e, 0xae, 0x36, 0x2b, 0x5a, 0x89,
+	0xb7, 0xfe, 0x33, 0x00, 0x1b, 0x60, 0x80, 0xe0, 0x2b, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1470,6 +2076,10 @@ type QueryClient interface {
 	// Balance queries the balance of a the EVM denomination for a single
 	// EthAccount.
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// PendingAccount queries the nonce and balance of an account on the
+	// "pending" state, which is the latest state with the given mempool txs
+	// applied on top of it.
+	PendingAccount(ctx context.Context, in *QueryPendingAccountRequest, opts ...grpc.CallOption) (*QueryPendingAccountResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
@@ -1480,6 +2090,10 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api. It executes a
+	// sequence of calls across one or more simulated blocks on top of a single
+	// state, so that each call observes the state changes of the calls before it.
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1490,6 +2104,9 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings: Lists the "FunToken" mappings with pagination and
+	// optional filters.
+	FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error)
 }
 
 type queryClient struct {
@@ -1527,6 +2144,15 @@ func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) PendingAccount(ctx context.Context, in *QueryPendingAccountRequest, opts ...grpc.CallOption) (*QueryPendingAccountResponse, error) {
+	out := new(QueryPendingAccountResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/PendingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
 	out := new(QueryStorageResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/Storage", in, out, opts...)
@@ -1572,6 +2198,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1617,6 +2252,15 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error) {
+	out := new(QueryFunTokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EthAccount queries a Nibiru account using its EVM address or Bech32 Nibiru
@@ -1628,6 +2272,10 @@ type QueryServer interface {
 	// Balance queries the balance of a the EVM denomination for a single
 	// EthAccount.
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// PendingAccount queries the nonce and balance of an account on the
+	// "pending" state, which is the latest state with the given mempool txs
+	// applied on top of it.
+	PendingAccount(context.Context, *QueryPendingAccountRequest) (*QueryPendingAccountResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
@@ -1638,6 +2286,10 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api. It executes a
+	// sequence of calls across one or more simulated blocks on top of a single
+	// state, so that each call observes the state changes of the calls before it.
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1648,6 +2300,9 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings: Lists the "FunToken" mappings with pagination and
+	// optional filters.
+	FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1663,6 +2318,9 @@ func (*UnimplementedQueryServer) ValidatorAccount(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
+func (*UnimplementedQueryServer) PendingAccount(ctx context.Context, req *QueryPendingAccountRequest) (*QueryPendingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAccount not implemented")
+}
 func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
@@ -1678,6 +2336,9 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -1693,6 +2354,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) FunTokenMapping(ctx context.Context, req *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (*UnimplementedQueryServer) FunTokenMappings(ctx context.Context, req *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMappings not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1752,6 +2416,24 @@ func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/PendingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAccount(ctx, req.(*QueryPendingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStorageRequest)
 	if err := dec(in); err != nil {
@@ -1842,6 +2524,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -1932,6 +2632,24 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FunTokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenMappings(ctx, req.(*QueryFunTokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1948,6 +2666,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balance",
 			Handler:    _Query_Balance_Handler,
 		},
+		{
+			MethodName: "PendingAccount",
+			Handler:    _Query_PendingAccount_Handler,
+		},
 		{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
@@ -1968,6 +2690,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -1988,6 +2714,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenMappings",
+			Handler:    _Query_FunTokenMappings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -2223,6 +2953,90 @@ func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppliedTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppliedTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BalanceWei) > 0 {
+		i -= len(m.BalanceWei)
+		copy(dAtA[i:], m.BalanceWei)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BalanceWei)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2517,6 +3331,34 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2544,7 +3386,7 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2554,25 +3396,44 @@ func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2582,24 +3443,226 @@ func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedCallResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedCallResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedCallResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevertReason) > 0 {
+		i -= len(m.RevertReason)
+		copy(dAtA[i:], m.RevertReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RevertReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
 		dAtA[i] = 0x48
 	}
 	if len(m.ProposerAddress) > 0 {
@@ -2951,6 +4014,107 @@ func (m *QueryFunTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFunTokenMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BankDenomPrefix) > 0 {
+		i -= len(m.BankDenomPrefix)
+		copy(dAtA[i:], m.BankDenomPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BankDenomPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunTokenMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunTokens) > 0 {
+		for iNdEx := len(m.FunTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3069,7 +4233,7 @@ func (m *QueryBalanceResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
+func (m *QueryPendingAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3079,11 +4243,49 @@ func (m *QueryStorageRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	if len(m.PendingTxs) > 0 {
+		for _, e := range m.PendingTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BalanceWei)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.AppliedTxs != 0 {
+		n += 1 + sovQuery(uint64(m.AppliedTxs))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryStorageResponse) Size() (n int) {
@@ -3201,6 +4403,121 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PendingTxs) > 0 {
+		for _, e := range m.PendingTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedCallResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3374,6 +4691,48 @@ func (m *QueryFunTokenMappingResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFunTokenMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
+	l = len(m.BankDenomPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FunTokens) > 0 {
+		for _, e := range m.FunTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4089,7 +5448,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,10 +5471,10 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4152,9 +5511,9 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4164,23 +5523,25 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.PendingTxs = append(m.PendingTxs, &MsgEthereumTx{})
+			if err := m.PendingTxs[len(m.PendingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4203,7 +5564,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4226,15 +5587,15 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWei", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4262,8 +5623,46 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.BalanceWei = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedTxs", wireType)
+			}
+			m.AppliedTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4285,7 +5684,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,10 +5707,10 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4346,6 +5745,38 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4367,7 +5798,171 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4957,6 +6552,775 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxs = append(m.PendingTxs, &MsgEthereumTx{})
+			if err := m.PendingTxs[len(m.PendingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, SimulatedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, SimulatedCallResult{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedCallResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedCallResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedCallResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5630,7 +7994,263 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var byteLen int
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5640,31 +8260,33 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5674,30 +8296,28 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFeeUnibi = &v
+			if err := m.BaseFeeUnibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5719,7 +8339,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5742,17 +8362,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5762,25 +8382,23 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5803,7 +8421,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5826,12 +8444,48 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FunToken == nil {
+				m.FunToken = &FunToken{}
+			}
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5853,7 +8507,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5876,17 +8530,17 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5896,31 +8550,50 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= FunTokenOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenomPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5948,11 +8621,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFeeUnibi = &v
-			if err := m.BaseFeeUnibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BankDenomPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5975,7 +8644,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5998,17 +8667,17 @@ func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6018,77 +8687,29 @@ func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.FunTokens = append(m.FunTokens, FunToken{})
+			if err := m.FunTokens[len(m.FunTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6115,13 +8736,32 @@ func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FunToken == nil {
-				m.FunToken = &FunToken{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,59 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks that can be simulated in a
+// single "eth_simulateV1" request.
+const MaxSimulateBlocks = 256
+
+// SimOpts are the inputs to "eth_simulateV1".
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.14.13/internal/ethapi/simulate.go
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	// Validation enables the checks of a real transaction: nonces must match
+	// the sender account, the fee cap must cover the base fee and the sender
+	// must be able to pay for gas and value.
+	Validation bool `json:"validation"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in one block, on
+// top of the given state and block overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+	StateOverrides StateOverride   `json:"stateOverrides"`
+	Calls          []JsonTxArgs    `json:"calls"`
+}
+
+// Validate checks the number of simulated blocks and the state overrides of
+// each block.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return fmt.Errorf("empty input: no blocks to simulate")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf(
+			"too many blocks: got %d, max is %d",
+			len(opts.BlockStateCalls), MaxSimulateBlocks,
+		)
+	}
+	for i, block := range opts.BlockStateCalls {
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SimOpts decodes and validates the JSON-encoded simulation options carried
+// by the request.
+func (req *SimulateV1Request) SimOpts() (opts SimOpts, err error) {
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return opts, fmt.Errorf("invalid simulate options: %w", err)
+	}
+	return opts, opts.Validate()
+}
@@ -148,6 +148,10 @@ type stateObject struct {
 	// object was previously existent and is being deployed as a contract within
 	// the current transaction.
 	newContract bool
+	// storageOverridden is true when the full contract storage was replaced
+	// with [StateDB.SetStorage]. Slots missing from the OriginStorage are then
+	// treated as empty rather than being loaded from the keeper.
+	storageOverridden bool
 }
 
 // newObject creates a state object.
@@ -294,6 +298,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.OriginStorage[key]; cached {
 		return value
 	}
+	if s.storageOverridden {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.evmTxCtx, s.Address(), key)
 	s.OriginStorage[key] = value
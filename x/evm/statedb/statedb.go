@@ -33,6 +33,8 @@ import (
 	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie/utils"
 	"github.com/holiman/uint256"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
 var _ vm.StateDB = &StateDB{}
@@ -91,12 +93,13 @@ func FromVM(evmObj *vm.EVM) *StateDB {
 // New creates a new state from a given trie.
 func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	return &StateDB{
-		keeper:       keeper,
-		evmTxCtx:     ctx,
-		stateObjects: make(map[common.Address]*stateObject),
-		Journal:      newJournal(),
-		accessList:   newAccessList(),
-		txConfig:     txConfig,
+		keeper:           keeper,
+		evmTxCtx:         ctx,
+		stateObjects:     make(map[common.Address]*stateObject),
+		transientStorage: make(transientStorage),
+		Journal:          newJournal(),
+		accessList:       newAccessList(),
+		txConfig:         txConfig,
 	}
 }
 
@@ -139,6 +142,26 @@ func (s *StateDB) AddLog(log *gethcore.Log) {
 	s.logs = append(s.logs, log)
 }
 
+// PrepareNextTx resets the per-transaction state (event logs, refund counter,
+// access list, transient storage and the created-in-this-tx flag of contracts)
+// and sets the [TxConfig] of the next transaction. This lets
+// a sequence of transactions run on the same uncommitted [StateDB], where each
+// transaction observes the state changes of the ones before it, as in
+// "eth_simulateV1".
+//
+// Clearing the created-in-this-tx flag keeps a contract created by an earlier
+// transaction from being destructed by a SELFDESTRUCT in a later one (EIP-6780).
+func (s *StateDB) PrepareNextTx(txConfig TxConfig) {
+	s.txConfig = txConfig
+	s.logs = nil
+	s.refund = 0
+	s.accessList = newAccessList()
+	s.transientStorage = make(transientStorage)
+	for _, obj := range s.stateObjects {
+		obj.newContract = false
+	}
+}
+
 // Logs returns the event logs of current transaction.
 func (s *StateDB) Logs() []*gethcore.Log {
 	return s.logs
@@ -453,6 +476,46 @@ func (s *StateDB) SetState(
 	return common.Hash{}
 }
 
+// SetStorage replaces the entire storage of the account with the given one.
+// Slots not present in "storage" read as empty. This is not journaled, as it is
+// only intended for simulations that apply state overrides before execution
+// and never commit.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	stateObject.OriginStorage = storage.Copy()
+	stateObject.DirtyStorage = make(Storage)
+	stateObject.storageOverridden = true
+}
+
+// ApplyStateOverride overrides the balance, nonce, code, and storage of the
+// accounts in the given [evm.StateOverride]. It is used for "eth_call" and
+// "eth_estimateGas" simulations against hypothetical state.
+func (s *StateDB) ApplyStateOverride(overrides evm.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			s.SetBalanceWei(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			s.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
 // SelfDestruct marks the given account as suicided.
 // This clears the account balance.
 //
@@ -491,7 +554,7 @@ func (s *StateDB) HasSelfDestructed(addr common.Address) bool {
 }
 
 // SelfDestruct6780 calls [SelfDesrtuct] only if the [stateObject] corresponding to
-// the given "addr" was created this block.
+// the given "addr" is a contract created in the current transaction.
 //
 // SelfDestruct6780 is post-EIP6780 selfdestruct, which means that it's a
 // send-all-to-beneficiary, unless the contract was created in this same
@@ -504,7 +567,7 @@ func (s *StateDB) SelfDestruct6780(
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
 		isSelfDestructed = false
-	} else if stateObject.createdThisBlock {
+	} else if stateObject.newContract {
 		prevWei, isSelfDestructed = s.SelfDestruct(addr), true
 	} else {
 		prevWei, isSelfDestructed = *(stateObject.Balance()), false
@@ -805,7 +868,7 @@ func (s *StateDB) SetTransientState(
 		key:       key,
 		prevValue: prev,
 	})
-	s.transientStorage.Set(addr, key, prev)
+	s.transientStorage.Set(addr, key, value)
 }
 
 // Witness returns nil.
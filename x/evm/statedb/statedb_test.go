@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -15,6 +16,7 @@ import (
 
 	xcommon "github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
@@ -327,6 +329,61 @@ func (s *Suite) TestState() {
 	}
 }
 
+func (s *Suite) TestApplyStateOverride() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	deps := evmtest.NewTestDeps()
+	db := deps.NewStateDB()
+	db.SetState(address, key1, value1)
+	db.SetState(address, key2, value2)
+	s.Require().NoError(db.Commit())
+
+	s.Run("stateDiff keeps untouched slots", func() {
+		db := deps.NewStateDB()
+		s.Require().NoError(db.ApplyStateOverride(evm.StateOverride{
+			address: {StateDiff: &map[common.Hash]common.Hash{key1: value2}},
+		}))
+		s.Equal(value2, db.GetState(address, key1))
+		s.Equal(value2, db.GetState(address, key2))
+	})
+
+	s.Run("state replaces the full storage", func() {
+		db := deps.NewStateDB()
+		s.Require().NoError(db.ApplyStateOverride(evm.StateOverride{
+			address: {State: &map[common.Hash]common.Hash{key1: value2}},
+		}))
+		s.Equal(value2, db.GetState(address, key1))
+		s.Equal(common.Hash{}, db.GetState(address, key2))
+	})
+
+	s.Run("balance, nonce, and code", func() {
+		db := deps.NewStateDB()
+		nonce := hexutil.Uint64(7)
+		code := hexutil.Bytes{0x60, 0x00}
+		balance := (*hexutil.Big)(big.NewInt(420))
+		s.Require().NoError(db.ApplyStateOverride(evm.StateOverride{
+			address2: {Nonce: &nonce, Code: &code, Balance: &balance},
+		}))
+		s.EqualValues(7, db.GetNonce(address2))
+		s.Equal([]byte(code), db.GetCode(address2))
+		s.Equal(uint256.NewInt(420), db.GetBalance(address2))
+	})
+
+	s.Run("sad: state and stateDiff", func() {
+		db := deps.NewStateDB()
+		err := db.ApplyStateOverride(evm.StateOverride{
+			address: {
+				State:     &map[common.Hash]common.Hash{key1: value2},
+				StateDiff: &map[common.Hash]common.Hash{key1: value2},
+			},
+		})
+		s.ErrorContains(err, "both 'state' and 'stateDiff'")
+	})
+}
+
 func (s *Suite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -476,6 +533,53 @@ func (s *Suite) TestNestedSnapshot() {
 	s.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+// TestTransientStorage: EIP-1153 transient storage follows the journal on
+// reverts and is cleared for the next transaction.
+func (s *Suite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	deps := evmtest.NewTestDeps()
+	db := deps.NewStateDB()
+	s.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	db.SetTransientState(address, key, value1)
+	s.Require().Equal(value1, db.GetTransientState(address, key))
+
+	rev := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	s.Require().Equal(value2, db.GetTransientState(address, key))
+	s.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	db.RevertToSnapshot(rev)
+	s.Require().Equal(value1, db.GetTransientState(address, key))
+
+	// Transient storage is never committed
+	s.Require().NoError(db.Commit())
+	s.Require().Equal(common.Hash{}, db.GetState(address, key))
+
+	db.PrepareNextTx(statedb.NewEmptyTxConfig(blockHash))
+	s.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
+// TestSelfDestruct6780AcrossTxs: A contract created by one transaction is not
+// destructed by a SELFDESTRUCT in the next transaction on the same StateDB.
+func (s *Suite) TestSelfDestruct6780AcrossTxs() {
+	deps := evmtest.NewTestDeps()
+	db := deps.NewStateDB()
+
+	db.CreateAccount(address)
+	db.CreateContract(address)
+	db.SetCode(address, []byte("code"))
+
+	db.PrepareNextTx(statedb.NewEmptyTxConfig(blockHash))
+	_, isSelfDestructed := db.SelfDestruct6780(address)
+	s.Require().False(isSelfDestructed)
+	s.Require().False(db.HasSelfDestructed(address))
+	s.Require().Equal([]byte("code"), db.GetCode(address))
+}
+
 func (s *Suite) TestInvalidSnapshotId() {
 	deps := evmtest.NewTestDeps()
 	db := deps.NewStateDB()
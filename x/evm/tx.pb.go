@@ -671,6 +671,112 @@ func (m *MsgConvertCoinToEvmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertCoinToEvmResponse proto.InternalMessageInfo
 
+// MsgConvertEvmToCoin: Arguments to send ERC-20 tokens to their Bank Coin
+// representation
+type MsgConvertEvmToCoin struct {
+	// Sender: Address for the signer of the transaction. The ERC20 tokens are
+	// sent from the EVM address that corresponds to the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	Erc20Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc20_addr"`
+	// Amount of ERC20 tokens to convert
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Bech32 address of the account that receives the Bank Coins
+	ToBankAddr string `protobuf:"bytes,4,opt,name=to_bank_addr,json=toBankAddr,proto3" json:"to_bank_addr,omitempty"`
+}
+
+func (m *MsgConvertEvmToCoin) Reset()         { *m = MsgConvertEvmToCoin{} }
+func (m *MsgConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoin) ProtoMessage()    {}
+func (*MsgConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{12}
+}
+func (m *MsgConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoin.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertEvmToCoin proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgConvertEvmToCoin) GetToBankAddr() string {
+	if m != nil {
+		return m.ToBankAddr
+	}
+	return ""
+}
+
+type MsgConvertEvmToCoinResponse struct {
+	// Bank Coin received by "to_bank_addr"
+	BankCoin types1.Coin `protobuf:"bytes,1,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *MsgConvertEvmToCoinResponse) Reset()         { *m = MsgConvertEvmToCoinResponse{} }
+func (m *MsgConvertEvmToCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoinResponse) ProtoMessage()    {}
+func (*MsgConvertEvmToCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{13}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertEvmToCoinResponse proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToCoinResponse) GetBankCoin() types1.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
@@ -684,90 +790,98 @@ func init() {
 	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
 	proto.RegisterType((*MsgConvertCoinToEvm)(nil), "eth.evm.v1.MsgConvertCoinToEvm")
 	proto.RegisterType((*MsgConvertCoinToEvmResponse)(nil), "eth.evm.v1.MsgConvertCoinToEvmResponse")
+	proto.RegisterType((*MsgConvertEvmToCoin)(nil), "eth.evm.v1.MsgConvertEvmToCoin")
+	proto.RegisterType((*MsgConvertEvmToCoinResponse)(nil), "eth.evm.v1.MsgConvertEvmToCoinResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
 
 var fileDescriptor_82a0bfe4f0bab953 = []byte{
-	// 1247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0xff, 0x3c, 0xbb, 0x49, 0xd8, 0xa6, 0xd4, 0x76, 0x5b, 0x6f, 0xd8, 0x8a,
-	0x12, 0x90, 0xb2, 0xdb, 0x18, 0xb5, 0x52, 0x73, 0x22, 0x4e, 0x5c, 0x54, 0x94, 0x40, 0xb4, 0x38,
-	0x3d, 0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0x92, 0x9d, 0x59, 0xed, 0x8c, 0x57, 0x0e, 0xc7,
-	0x9e, 0x90, 0x38, 0x00, 0xe2, 0x0b, 0x70, 0xe0, 0xd4, 0x13, 0x87, 0x1e, 0xf8, 0x08, 0x15, 0xa7,
-	0x0a, 0x0e, 0xa0, 0x1e, 0x0c, 0x4a, 0x91, 0x90, 0x7a, 0xec, 0x81, 0x1b, 0x12, 0x9a, 0xd9, 0xb5,
-	0x63, 0x27, 0x38, 0x85, 0x08, 0x71, 0x9b, 0x37, 0xef, 0xcf, 0xbc, 0xf7, 0xfb, 0xbd, 0x79, 0x33,
-	0x70, 0x11, 0xf3, 0xae, 0x89, 0x23, 0xdf, 0x8c, 0x56, 0x4d, 0xde, 0x37, 0x82, 0x90, 0x72, 0xaa,
-	0x02, 0xe6, 0x5d, 0x03, 0x47, 0xbe, 0x11, 0xad, 0x56, 0x2f, 0xdb, 0x94, 0xf9, 0x94, 0x99, 0x3e,
-	0x73, 0x85, 0x8d, 0xcf, 0xdc, 0xd8, 0xa8, 0x5a, 0x4b, 0x14, 0x1d, 0xc4, 0xb0, 0x19, 0xad, 0x76,
-	0x30, 0x47, 0xab, 0xa6, 0x4d, 0x3d, 0x92, 0xe8, 0x2b, 0xb1, 0xbe, 0x2d, 0x25, 0x33, 0x16, 0x12,
-	0xd5, 0xe2, 0xd8, 0xa1, 0xe2, 0x98, 0x64, 0xd7, 0xa5, 0x2e, 0x8d, 0xad, 0xc5, 0x2a, 0xd9, 0xbd,
-	0xea, 0x52, 0xea, 0x1e, 0x60, 0x13, 0x05, 0x9e, 0x89, 0x08, 0xa1, 0x1c, 0x71, 0x8f, 0x92, 0x61,
-	0xa4, 0x4a, 0xa2, 0x95, 0x52, 0xa7, 0xb7, 0x67, 0x22, 0x72, 0x18, 0xab, 0xf4, 0xcf, 0x15, 0xb8,
-	0xb0, 0xcd, 0xdc, 0x26, 0xef, 0xe2, 0x10, 0xf7, 0xfc, 0x56, 0x5f, 0x5d, 0x86, 0x8c, 0x83, 0x38,
-	0x2a, 0x2b, 0x4b, 0xca, 0x72, 0xb1, 0xbe, 0x68, 0xc4, 0xbe, 0xc6, 0xd0, 0xd7, 0x58, 0x27, 0x87,
-	0x96, 0xb4, 0x50, 0x2b, 0x90, 0x61, 0xde, 0x27, 0xb8, 0x9c, 0x5a, 0x52, 0x96, 0x95, 0xc6, 0xec,
-	0xf3, 0x81, 0xa6, 0xac, 0x58, 0x72, 0x4b, 0xd5, 0x20, 0xd3, 0x45, 0xac, 0x5b, 0x4e, 0x2f, 0x29,
-	0xcb, 0x85, 0x46, 0xf1, 0xc5, 0x40, 0xcb, 0x85, 0x07, 0xc1, 0x9a, 0xbe, 0xa2, 0x5b, 0x52, 0xa1,
-	0xaa, 0x90, 0xd9, 0x0b, 0xa9, 0x5f, 0xce, 0x08, 0x03, 0x4b, 0xae, 0xd7, 0x32, 0x9f, 0x7e, 0xad,
-	0xcd, 0xe8, 0x5f, 0xa6, 0x20, 0xbf, 0x85, 0x5d, 0x64, 0x1f, 0xb6, 0xfa, 0xea, 0x22, 0xcc, 0x12,
-	0x4a, 0x6c, 0x2c, 0xb3, 0xc9, 0x58, 0xb1, 0xa0, 0xde, 0x86, 0x82, 0x8b, 0x04, 0x66, 0x9e, 0x1d,
-	0x9f, 0x5e, 0x68, 0x54, 0x9e, 0x0e, 0xb4, 0x4b, 0x31, 0x7c, 0xcc, 0xd9, 0x37, 0x3c, 0x6a, 0xfa,
-	0x88, 0x77, 0x8d, 0x7b, 0x84, 0x5b, 0x79, 0x17, 0xb1, 0x1d, 0x61, 0xaa, 0xd6, 0x20, 0xed, 0x22,
-	0x26, 0x93, 0xca, 0x34, 0x4a, 0x47, 0x03, 0x2d, 0xff, 0x2e, 0x62, 0x5b, 0x9e, 0xef, 0x71, 0x4b,
-	0x28, 0xd4, 0x39, 0x48, 0x71, 0x9a, 0xa4, 0x94, 0xe2, 0x54, 0xbd, 0x03, 0xb3, 0x11, 0x3a, 0xe8,
-	0xe1, 0xf2, 0xac, 0x3c, 0xe3, 0xfa, 0xd4, 0x33, 0x8e, 0x06, 0x5a, 0x76, 0xdd, 0xa7, 0x3d, 0xc2,
-	0xad, 0xd8, 0x43, 0xd4, 0x27, 0x51, 0xcc, 0x2e, 0x29, 0xcb, 0xa5, 0x04, 0xaf, 0x12, 0x28, 0x51,
-	0x39, 0x27, 0x37, 0x94, 0x48, 0x48, 0x61, 0x39, 0x1f, 0x4b, 0xa1, 0x90, 0x58, 0xb9, 0x10, 0x4b,
-	0x6c, 0x6d, 0x4e, 0x20, 0xf1, 0xfd, 0xa3, 0x95, 0x6c, 0xab, 0xbf, 0x89, 0x38, 0xd2, 0xbf, 0x4b,
-	0x43, 0x69, 0xdd, 0xb6, 0x31, 0x63, 0x5b, 0x1e, 0xe3, 0xad, 0xbe, 0xfa, 0x1e, 0xe4, 0xed, 0x2e,
-	0xf2, 0x48, 0xdb, 0x73, 0x24, 0x34, 0x85, 0x86, 0x79, 0x56, 0x72, 0xb9, 0x0d, 0x61, 0x7c, 0x6f,
-	0xf3, 0xf9, 0x40, 0xcb, 0xd9, 0xf1, 0xd2, 0x4a, 0x16, 0xce, 0x31, 0xc6, 0xa9, 0xa9, 0x18, 0xa7,
-	0xff, 0x35, 0xc6, 0x99, 0xb3, 0x31, 0x9e, 0x3d, 0x8d, 0x71, 0xf6, 0xdc, 0x18, 0xe7, 0xc6, 0x30,
-	0xde, 0x85, 0x3c, 0x92, 0x40, 0x61, 0x56, 0xce, 0x2f, 0xa5, 0x97, 0x8b, 0xf5, 0xcb, 0xc6, 0xf1,
-	0x3d, 0x35, 0x62, 0x10, 0x5b, 0xbd, 0xe0, 0x00, 0x37, 0x96, 0x1e, 0x0f, 0xb4, 0x99, 0xe7, 0x03,
-	0x0d, 0xd0, 0x08, 0xd9, 0x87, 0xbf, 0x68, 0x70, 0x8c, 0xb3, 0x35, 0x0a, 0x15, 0x53, 0x57, 0x98,
-	0xa0, 0x0e, 0x26, 0xa8, 0x2b, 0x4e, 0xa3, 0xee, 0x8f, 0x34, 0x94, 0x36, 0x0f, 0x09, 0xf2, 0x3d,
-	0xfb, 0x2e, 0xc6, 0xff, 0x0b, 0x75, 0x77, 0xa0, 0x28, 0xa8, 0xe3, 0x5e, 0xd0, 0xb6, 0x51, 0xf0,
-	0x72, 0xf2, 0x04, 0xd1, 0x2d, 0x2f, 0xd8, 0x40, 0xc1, 0xd0, 0x75, 0x0f, 0x63, 0xe9, 0x9a, 0xf9,
-	0x27, 0xae, 0x77, 0x31, 0x16, 0xae, 0x09, 0xf1, 0xb3, 0x67, 0x13, 0x9f, 0x3d, 0x4d, 0x7c, 0xee,
-	0xdc, 0xc4, 0xe7, 0xa7, 0x10, 0x5f, 0xf8, 0x8f, 0x89, 0x87, 0x09, 0xe2, 0x8b, 0x13, 0xc4, 0x97,
-	0xa6, 0x11, 0xaf, 0x43, 0xb5, 0xd9, 0xe7, 0x98, 0x30, 0x8f, 0x92, 0x0f, 0x02, 0x39, 0x8e, 0x8f,
-	0xa7, 0x6c, 0x32, 0xeb, 0xbe, 0x51, 0xe0, 0xd2, 0xc4, 0xf4, 0xb5, 0x30, 0x0b, 0x28, 0x61, 0xb2,
-	0x44, 0x39, 0x40, 0x95, 0x78, 0x3e, 0xca, 0x99, 0xf9, 0x26, 0x64, 0x0e, 0xa8, 0xcb, 0xca, 0x29,
-	0x59, 0xde, 0xfc, 0x78, 0x79, 0x5b, 0xd4, 0x6d, 0x64, 0x44, 0x59, 0x96, 0x34, 0x51, 0x17, 0x20,
-	0x1d, 0x62, 0x2e, 0xa9, 0x2f, 0x59, 0x62, 0xa9, 0x56, 0x20, 0x1f, 0xf9, 0x6d, 0x1c, 0x86, 0x34,
-	0x4c, 0x26, 0x5c, 0x2e, 0xf2, 0x9b, 0x42, 0x14, 0x2a, 0x41, 0x7a, 0x8f, 0x61, 0x27, 0xa6, 0xcf,
-	0xca, 0xb9, 0x88, 0xed, 0x32, 0xec, 0x24, 0x69, 0x7e, 0xa6, 0xc0, 0xfc, 0x36, 0x73, 0x77, 0x03,
-	0x07, 0x71, 0xbc, 0x83, 0x42, 0xe4, 0x33, 0x31, 0x1f, 0x50, 0x8f, 0x77, 0x69, 0xe8, 0xf1, 0xc3,
-	0xa4, 0x8f, 0xcb, 0x3f, 0x3c, 0x5a, 0x59, 0x4c, 0x9e, 0xb0, 0x75, 0xc7, 0x09, 0x31, 0x63, 0x1f,
-	0xf2, 0xd0, 0x23, 0xae, 0x75, 0x6c, 0xaa, 0xde, 0x84, 0x6c, 0x20, 0x23, 0xc8, 0x9e, 0x2d, 0xd6,
-	0xd5, 0xf1, 0x32, 0xe2, 0xd8, 0x49, 0x25, 0x89, 0xdd, 0xda, 0xdc, 0x83, 0xdf, 0xbf, 0x7d, 0xeb,
-	0x38, 0x82, 0x5e, 0x81, 0xcb, 0x27, 0x92, 0x19, 0xa2, 0xa6, 0x3f, 0x54, 0xe0, 0x95, 0x6d, 0xe6,
-	0x6e, 0x84, 0x18, 0x71, 0x7c, 0xb7, 0x47, 0x5a, 0x74, 0x1f, 0x13, 0x75, 0x17, 0x40, 0xbc, 0x2f,
-	0x6d, 0x1c, 0xda, 0xf5, 0x9b, 0x49, 0xae, 0xb7, 0x1f, 0x0f, 0x34, 0xe5, 0xe9, 0x40, 0x33, 0x5c,
-	0x8f, 0x77, 0x7b, 0x1d, 0xc3, 0xa6, 0xbe, 0xf9, 0xbe, 0xd7, 0xf1, 0xc2, 0x9e, 0xbc, 0x6f, 0x26,
-	0x91, 0x6b, 0x33, 0xaa, 0x9b, 0x22, 0xbd, 0xe6, 0xbd, 0x9d, 0x5b, 0xb7, 0x44, 0x49, 0x56, 0x41,
-	0x44, 0x6a, 0x8a, 0x40, 0xea, 0x0d, 0x98, 0x97, 0x61, 0x3b, 0x88, 0xec, 0xb7, 0x1d, 0x4c, 0xa8,
-	0x1f, 0xbf, 0x45, 0xd6, 0x05, 0xb1, 0xdd, 0x40, 0x64, 0x7f, 0x53, 0x6c, 0xaa, 0xaf, 0x42, 0x96,
-	0x61, 0xe2, 0xe0, 0x30, 0xbe, 0x89, 0x56, 0x22, 0xe9, 0x1d, 0xa8, 0x9c, 0xca, 0x75, 0xc4, 0x7f,
-	0x13, 0x16, 0xf6, 0x7a, 0x84, 0x8b, 0xbd, 0xb6, 0x8f, 0x82, 0xc0, 0x23, 0xee, 0xe8, 0x45, 0x1e,
-	0x03, 0x6c, 0xe8, 0x97, 0x40, 0x36, 0x3f, 0xf4, 0xd9, 0x8e, 0x5d, 0xf4, 0x9f, 0x14, 0xb8, 0x28,
-	0x0e, 0xa1, 0x24, 0xc2, 0x21, 0xdf, 0xa0, 0x1e, 0x69, 0xd1, 0x66, 0xe4, 0xab, 0xf7, 0xa1, 0xc8,
-	0x69, 0x1b, 0xf3, 0x6e, 0x1b, 0x39, 0x4e, 0x38, 0x86, 0xc9, 0xcc, 0x79, 0x30, 0xe1, 0xb4, 0xc9,
-	0xbb, 0x62, 0x39, 0x56, 0x6b, 0x6a, 0xbc, 0x56, 0x75, 0x07, 0x0a, 0x12, 0x26, 0xf1, 0xf3, 0x91,
-	0x30, 0x14, 0xeb, 0x15, 0x23, 0x69, 0x15, 0xf1, 0x35, 0x32, 0x92, 0xaf, 0x91, 0x21, 0x52, 0x6c,
-	0x94, 0x45, 0x22, 0x2f, 0x06, 0xda, 0xc2, 0x21, 0xf2, 0x0f, 0xd6, 0xf4, 0x91, 0xa7, 0x6e, 0xe5,
-	0xc5, 0x5a, 0xd8, 0xe8, 0xd7, 0xe0, 0xca, 0xdf, 0x14, 0x36, 0xc4, 0xaf, 0xfe, 0x67, 0x0a, 0xd2,
-	0xdb, 0xcc, 0x55, 0x09, 0xc0, 0xd8, 0xdf, 0xa6, 0x32, 0x8e, 0xdd, 0xc4, 0xc5, 0xab, 0xbe, 0x36,
-	0x55, 0x35, 0xea, 0x2e, 0xfd, 0xc1, 0x8f, 0xbf, 0x7d, 0x95, 0xba, 0xaa, 0x57, 0x87, 0x48, 0x0c,
-	0x3f, 0x67, 0x89, 0x69, 0x9b, 0xf7, 0xd5, 0x1d, 0x28, 0x4d, 0x5c, 0x93, 0x2b, 0x27, 0xc2, 0x8e,
-	0x2b, 0xab, 0xd7, 0xcf, 0x50, 0x8e, 0x3a, 0xe1, 0x3e, 0xcc, 0x9d, 0xe8, 0xe7, 0x6b, 0x27, 0xdc,
-	0x26, 0xd5, 0xd5, 0xd7, 0xcf, 0x54, 0x8f, 0xe2, 0x7e, 0x0c, 0x0b, 0xa7, 0xda, 0x42, 0x3b, 0xe9,
-	0x7a, 0xc2, 0xa0, 0xfa, 0xc6, 0x4b, 0x0c, 0x86, 0xd1, 0x1b, 0xef, 0x3c, 0x3e, 0xaa, 0x29, 0x4f,
-	0x8e, 0x6a, 0xca, 0xaf, 0x47, 0x35, 0xe5, 0x8b, 0x67, 0xb5, 0x99, 0x27, 0xcf, 0x6a, 0x33, 0x3f,
-	0x3f, 0xab, 0xcd, 0x7c, 0x74, 0xe3, 0xa5, 0xdd, 0xd5, 0x17, 0xc0, 0x76, 0xb2, 0xf2, 0xc7, 0xf9,
-	0xf6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xdd, 0x8f, 0x11, 0x7c, 0x0b, 0x00, 0x00,
+	// 1333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x8e, 0x7f, 0x3c, 0xbb, 0x49, 0xbe, 0xdb, 0xf4, 0x5b, 0xdb, 0x6d, 0xbd, 0x61,
+	0x2b, 0xda, 0x80, 0x94, 0xdd, 0xc6, 0xa8, 0x95, 0x9a, 0x13, 0x71, 0xe2, 0xa2, 0xa2, 0x04, 0xa2,
+	0xc5, 0xe9, 0x01, 0x21, 0x59, 0x63, 0xef, 0x64, 0xbd, 0x4a, 0x76, 0x66, 0xb5, 0x33, 0x5e, 0x39,
+	0x1c, 0x7b, 0x42, 0xe2, 0x00, 0x88, 0x7f, 0x80, 0x03, 0xa7, 0x9e, 0x38, 0xf4, 0xc0, 0x9f, 0x50,
+	0x71, 0xaa, 0xe0, 0x00, 0x2a, 0xc2, 0xa0, 0x14, 0x09, 0xa9, 0xc7, 0x1e, 0x38, 0xa3, 0x99, 0x5d,
+	0xff, 0x4a, 0xea, 0x04, 0x4a, 0xc5, 0x6d, 0xde, 0xbc, 0x1f, 0xf3, 0xde, 0xe7, 0xf3, 0xe6, 0xed,
+	0x2c, 0x9c, 0xc7, 0xbc, 0x63, 0xe2, 0xd0, 0x33, 0xc3, 0x55, 0x93, 0xf7, 0x0c, 0x3f, 0xa0, 0x9c,
+	0xaa, 0x80, 0x79, 0xc7, 0xc0, 0xa1, 0x67, 0x84, 0xab, 0xe5, 0x8b, 0x6d, 0xca, 0x3c, 0xca, 0x4c,
+	0x8f, 0x39, 0xc2, 0xc6, 0x63, 0x4e, 0x64, 0x54, 0xae, 0xc4, 0x8a, 0x16, 0x62, 0xd8, 0x0c, 0x57,
+	0x5b, 0x98, 0xa3, 0x55, 0xb3, 0x4d, 0x5d, 0x12, 0xeb, 0x4b, 0x91, 0xbe, 0x29, 0x25, 0x33, 0x12,
+	0x62, 0xd5, 0xe2, 0xd8, 0xa1, 0xe2, 0x98, 0x78, 0xd7, 0xa1, 0x0e, 0x8d, 0xac, 0xc5, 0x2a, 0xde,
+	0xbd, 0xec, 0x50, 0xea, 0x1c, 0x60, 0x13, 0xf9, 0xae, 0x89, 0x08, 0xa1, 0x1c, 0x71, 0x97, 0x92,
+	0x41, 0xa4, 0x52, 0xac, 0x95, 0x52, 0xab, 0xbb, 0x67, 0x22, 0x72, 0x18, 0xa9, 0xf4, 0xcf, 0x14,
+	0x38, 0xb7, 0xcd, 0x9c, 0x3a, 0xef, 0xe0, 0x00, 0x77, 0xbd, 0x46, 0x4f, 0x5d, 0x86, 0x94, 0x8d,
+	0x38, 0x2a, 0x2a, 0x4b, 0xca, 0x72, 0xbe, 0xba, 0x68, 0x44, 0xbe, 0xc6, 0xc0, 0xd7, 0x58, 0x27,
+	0x87, 0x96, 0xb4, 0x50, 0x4b, 0x90, 0x62, 0xee, 0xc7, 0xb8, 0x98, 0x58, 0x52, 0x96, 0x95, 0xda,
+	0xec, 0xb3, 0xbe, 0xa6, 0xac, 0x58, 0x72, 0x4b, 0xd5, 0x20, 0xd5, 0x41, 0xac, 0x53, 0x4c, 0x2e,
+	0x29, 0xcb, 0xb9, 0x5a, 0xfe, 0x79, 0x5f, 0xcb, 0x04, 0x07, 0xfe, 0x9a, 0xbe, 0xa2, 0x5b, 0x52,
+	0xa1, 0xaa, 0x90, 0xda, 0x0b, 0xa8, 0x57, 0x4c, 0x09, 0x03, 0x4b, 0xae, 0xd7, 0x52, 0x9f, 0x7c,
+	0xa5, 0xcd, 0xe8, 0x5f, 0x24, 0x20, 0xbb, 0x85, 0x1d, 0xd4, 0x3e, 0x6c, 0xf4, 0xd4, 0x45, 0x98,
+	0x25, 0x94, 0xb4, 0xb1, 0xcc, 0x26, 0x65, 0x45, 0x82, 0x7a, 0x0b, 0x72, 0x0e, 0x12, 0x98, 0xb9,
+	0xed, 0xe8, 0xf4, 0x5c, 0xad, 0xf4, 0xa4, 0xaf, 0x5d, 0x88, 0xe0, 0x63, 0xf6, 0xbe, 0xe1, 0x52,
+	0xd3, 0x43, 0xbc, 0x63, 0xdc, 0x25, 0xdc, 0xca, 0x3a, 0x88, 0xed, 0x08, 0x53, 0xb5, 0x02, 0x49,
+	0x07, 0x31, 0x99, 0x54, 0xaa, 0x56, 0x38, 0xea, 0x6b, 0xd9, 0x77, 0x10, 0xdb, 0x72, 0x3d, 0x97,
+	0x5b, 0x42, 0xa1, 0xce, 0x41, 0x82, 0xd3, 0x38, 0xa5, 0x04, 0xa7, 0xea, 0x6d, 0x98, 0x0d, 0xd1,
+	0x41, 0x17, 0x17, 0x67, 0xe5, 0x19, 0x57, 0xa7, 0x9e, 0x71, 0xd4, 0xd7, 0xd2, 0xeb, 0x1e, 0xed,
+	0x12, 0x6e, 0x45, 0x1e, 0xa2, 0x3e, 0x89, 0x62, 0x7a, 0x49, 0x59, 0x2e, 0xc4, 0x78, 0x15, 0x40,
+	0x09, 0x8b, 0x19, 0xb9, 0xa1, 0x84, 0x42, 0x0a, 0x8a, 0xd9, 0x48, 0x0a, 0x84, 0xc4, 0x8a, 0xb9,
+	0x48, 0x62, 0x6b, 0x73, 0x02, 0x89, 0xef, 0x1e, 0xae, 0xa4, 0x1b, 0xbd, 0x4d, 0xc4, 0x91, 0xfe,
+	0x6d, 0x12, 0x0a, 0xeb, 0xed, 0x36, 0x66, 0x6c, 0xcb, 0x65, 0xbc, 0xd1, 0x53, 0xdf, 0x85, 0x6c,
+	0xbb, 0x83, 0x5c, 0xd2, 0x74, 0x6d, 0x09, 0x4d, 0xae, 0x66, 0x9e, 0x96, 0x5c, 0x66, 0x43, 0x18,
+	0xdf, 0xdd, 0x7c, 0xd6, 0xd7, 0x32, 0xed, 0x68, 0x69, 0xc5, 0x0b, 0x7b, 0x84, 0x71, 0x62, 0x2a,
+	0xc6, 0xc9, 0x7f, 0x8c, 0x71, 0xea, 0x74, 0x8c, 0x67, 0x4f, 0x62, 0x9c, 0x7e, 0x69, 0x8c, 0x33,
+	0x63, 0x18, 0xef, 0x42, 0x16, 0x49, 0xa0, 0x30, 0x2b, 0x66, 0x97, 0x92, 0xcb, 0xf9, 0xea, 0x45,
+	0x63, 0x74, 0x4f, 0x8d, 0x08, 0xc4, 0x46, 0xd7, 0x3f, 0xc0, 0xb5, 0xa5, 0x47, 0x7d, 0x6d, 0xe6,
+	0x59, 0x5f, 0x03, 0x34, 0x44, 0xf6, 0xc1, 0xaf, 0x1a, 0x8c, 0x70, 0xb6, 0x86, 0xa1, 0x22, 0xea,
+	0x72, 0x13, 0xd4, 0xc1, 0x04, 0x75, 0xf9, 0x69, 0xd4, 0xfd, 0x99, 0x84, 0xc2, 0xe6, 0x21, 0x41,
+	0x9e, 0xdb, 0xbe, 0x83, 0xf1, 0x7f, 0x42, 0xdd, 0x6d, 0xc8, 0x0b, 0xea, 0xb8, 0xeb, 0x37, 0xdb,
+	0xc8, 0x3f, 0x9b, 0x3c, 0x41, 0x74, 0xc3, 0xf5, 0x37, 0x90, 0x3f, 0x70, 0xdd, 0xc3, 0x58, 0xba,
+	0xa6, 0xfe, 0x8e, 0xeb, 0x1d, 0x8c, 0x85, 0x6b, 0x4c, 0xfc, 0xec, 0xe9, 0xc4, 0xa7, 0x4f, 0x12,
+	0x9f, 0x79, 0x69, 0xe2, 0xb3, 0x53, 0x88, 0xcf, 0xbd, 0x62, 0xe2, 0x61, 0x82, 0xf8, 0xfc, 0x04,
+	0xf1, 0x85, 0x69, 0xc4, 0xeb, 0x50, 0xae, 0xf7, 0x38, 0x26, 0xcc, 0xa5, 0xe4, 0x7d, 0x5f, 0x8e,
+	0xe3, 0xd1, 0x94, 0x8d, 0x67, 0xdd, 0xd7, 0x0a, 0x5c, 0x98, 0x98, 0xbe, 0x16, 0x66, 0x3e, 0x25,
+	0x4c, 0x96, 0x28, 0x07, 0xa8, 0x12, 0xcd, 0x47, 0xb1, 0x56, 0xdf, 0x80, 0xd4, 0x01, 0x75, 0x58,
+	0x31, 0x21, 0xcb, 0x9b, 0x1f, 0x2f, 0x6f, 0x8b, 0x3a, 0xb5, 0x94, 0x28, 0xcb, 0x92, 0x26, 0xea,
+	0x02, 0x24, 0x03, 0xcc, 0x25, 0xf5, 0x05, 0x4b, 0x2c, 0xd5, 0x12, 0x64, 0x43, 0xaf, 0x89, 0x83,
+	0x80, 0x06, 0xf1, 0x84, 0xcb, 0x84, 0x5e, 0x5d, 0x88, 0x42, 0x25, 0x48, 0xef, 0x32, 0x6c, 0x47,
+	0xf4, 0x59, 0x19, 0x07, 0xb1, 0x5d, 0x86, 0xed, 0x38, 0xcd, 0x4f, 0x15, 0x98, 0xdf, 0x66, 0xce,
+	0xae, 0x6f, 0x23, 0x8e, 0x77, 0x50, 0x80, 0x3c, 0x26, 0xe6, 0x03, 0xea, 0xf2, 0x0e, 0x0d, 0x5c,
+	0x7e, 0x18, 0xf7, 0x71, 0xf1, 0xfb, 0x87, 0x2b, 0x8b, 0xf1, 0x27, 0x6c, 0xdd, 0xb6, 0x03, 0xcc,
+	0xd8, 0x07, 0x3c, 0x70, 0x89, 0x63, 0x8d, 0x4c, 0xd5, 0x1b, 0x90, 0xf6, 0x65, 0x04, 0xd9, 0xb3,
+	0xf9, 0xaa, 0x3a, 0x5e, 0x46, 0x14, 0x3b, 0xae, 0x24, 0xb6, 0x5b, 0x9b, 0xbb, 0xff, 0xc7, 0x37,
+	0x6f, 0x8e, 0x22, 0xe8, 0x25, 0xb8, 0x78, 0x2c, 0x99, 0x01, 0x6a, 0xfa, 0x03, 0x05, 0xfe, 0xb7,
+	0xcd, 0x9c, 0x8d, 0x00, 0x23, 0x8e, 0xef, 0x74, 0x49, 0x83, 0xee, 0x63, 0xa2, 0xee, 0x02, 0x88,
+	0xef, 0x4b, 0x13, 0x07, 0xed, 0xea, 0x8d, 0x38, 0xd7, 0x5b, 0x8f, 0xfa, 0x9a, 0xf2, 0xa4, 0xaf,
+	0x19, 0x8e, 0xcb, 0x3b, 0xdd, 0x96, 0xd1, 0xa6, 0x9e, 0xf9, 0x9e, 0xdb, 0x72, 0x83, 0xae, 0xbc,
+	0x6f, 0x26, 0x91, 0x6b, 0x33, 0xac, 0x9a, 0x22, 0xbd, 0xfa, 0xdd, 0x9d, 0x9b, 0x37, 0x45, 0x49,
+	0x56, 0x4e, 0x44, 0xaa, 0x8b, 0x40, 0xea, 0x35, 0x98, 0x97, 0x61, 0x5b, 0x88, 0xec, 0x37, 0x6d,
+	0x4c, 0xa8, 0x17, 0x7d, 0x8b, 0xac, 0x73, 0x62, 0xbb, 0x86, 0xc8, 0xfe, 0xa6, 0xd8, 0x54, 0xff,
+	0x0f, 0x69, 0x86, 0x89, 0x8d, 0x83, 0xe8, 0x26, 0x5a, 0xb1, 0xa4, 0xb7, 0xa0, 0x74, 0x22, 0xd7,
+	0x21, 0xff, 0x75, 0x58, 0xd8, 0xeb, 0x12, 0x2e, 0xf6, 0x9a, 0x1e, 0xf2, 0x7d, 0x97, 0x38, 0xc3,
+	0x2f, 0xf2, 0x18, 0x60, 0x03, 0xbf, 0x18, 0xb2, 0xf9, 0x81, 0xcf, 0x76, 0xe4, 0xa2, 0xff, 0xa8,
+	0xc0, 0x79, 0x71, 0x08, 0x25, 0x21, 0x0e, 0xf8, 0x06, 0x75, 0x49, 0x83, 0xd6, 0x43, 0x4f, 0xbd,
+	0x07, 0x79, 0x4e, 0x9b, 0x98, 0x77, 0x9a, 0xc8, 0xb6, 0x83, 0x31, 0x4c, 0x66, 0x5e, 0x06, 0x13,
+	0x4e, 0xeb, 0xbc, 0x23, 0x96, 0x63, 0xb5, 0x26, 0xc6, 0x6b, 0x55, 0x77, 0x20, 0x27, 0x61, 0x12,
+	0x2f, 0x1f, 0x09, 0x43, 0xbe, 0x5a, 0x32, 0xe2, 0x56, 0x11, 0x4f, 0x23, 0x23, 0x7e, 0x1a, 0x19,
+	0x22, 0xc5, 0x5a, 0x51, 0x24, 0xf2, 0xbc, 0xaf, 0x2d, 0x1c, 0x22, 0xef, 0x60, 0x4d, 0x1f, 0x7a,
+	0xea, 0x56, 0x56, 0xac, 0x85, 0x8d, 0x7e, 0x05, 0x2e, 0xbd, 0xa0, 0xb0, 0x61, 0x27, 0xfc, 0x32,
+	0x51, 0x78, 0x3d, 0xf4, 0x1a, 0x54, 0x18, 0x8d, 0x25, 0xa8, 0x4c, 0x24, 0xb8, 0x0b, 0x20, 0xdb,
+	0x23, 0xc2, 0x23, 0xf1, 0xef, 0xf0, 0x90, 0x91, 0x24, 0x1e, 0x37, 0x21, 0x8d, 0xe4, 0xe8, 0x8a,
+	0xa7, 0xf0, 0x95, 0x38, 0xe4, 0x94, 0x71, 0x1a, 0x1b, 0xab, 0x4b, 0x50, 0xe0, 0x34, 0x6a, 0x2c,
+	0x99, 0x4f, 0x74, 0x61, 0x81, 0x53, 0xd1, 0x55, 0x22, 0xb0, 0x4e, 0xe1, 0xd2, 0x0b, 0xca, 0x1b,
+	0xb6, 0xcf, 0x04, 0xde, 0xca, 0x2b, 0xc0, 0xbb, 0xfa, 0x73, 0x12, 0x92, 0xdb, 0xcc, 0x51, 0x09,
+	0xc0, 0xd8, 0x63, 0xb1, 0x34, 0xde, 0x8c, 0x13, 0x93, 0xac, 0xfc, 0xda, 0x54, 0xd5, 0x90, 0x24,
+	0xfd, 0xfe, 0x0f, 0xbf, 0x7f, 0x99, 0xb8, 0xac, 0x97, 0x07, 0x50, 0x0e, 0x5e, 0xbb, 0xb1, 0x69,
+	0x93, 0xf7, 0xd4, 0x1d, 0x28, 0x4c, 0xcc, 0x9d, 0x4b, 0xc7, 0xc2, 0x8e, 0x2b, 0xcb, 0x57, 0x4f,
+	0x51, 0x0e, 0xb1, 0xb9, 0x07, 0x73, 0xc7, 0x06, 0xc4, 0x95, 0x63, 0x6e, 0x93, 0xea, 0xf2, 0xeb,
+	0xa7, 0xaa, 0x87, 0x71, 0x3f, 0x82, 0x85, 0x13, 0xf7, 0x4c, 0x3b, 0xee, 0x7a, 0xcc, 0xa0, 0x7c,
+	0xfd, 0x0c, 0x83, 0x17, 0x44, 0x1f, 0x35, 0xf3, 0x94, 0xe8, 0x43, 0x83, 0xf2, 0xf5, 0x33, 0x0c,
+	0x06, 0xd1, 0x6b, 0x6f, 0x3f, 0x3a, 0xaa, 0x28, 0x8f, 0x8f, 0x2a, 0xca, 0x6f, 0x47, 0x15, 0xe5,
+	0xf3, 0xa7, 0x95, 0x99, 0xc7, 0x4f, 0x2b, 0x33, 0x3f, 0x3d, 0xad, 0xcc, 0x7c, 0x78, 0xed, 0xcc,
+	0xe6, 0xef, 0x09, 0xda, 0x5a, 0x69, 0xf9, 0x83, 0xf0, 0xd6, 0x5f, 0x03, 0x00, 0x01, 0xbc, 0x86,
+	0x21, 0x2b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -796,6 +910,10 @@ type MsgClient interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping to
+	// the given recipient address ("to_bank_addr") in the corresponding Bank
+	// Coin representation. It is the inverse of "ConvertCoinToEvm".
+	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
 }
 
 type msgClient struct {
@@ -842,6 +960,15 @@ func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEv
 	return out, nil
 }
 
+func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error) {
+	out := new(MsgConvertEvmToCoinResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -858,6 +985,10 @@ type MsgServer interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping to
+	// the given recipient address ("to_bank_addr") in the corresponding Bank
+	// Coin representation. It is the inverse of "ConvertCoinToEvm".
+	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -876,6 +1007,9 @@ func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreat
 func (*UnimplementedMsgServer) ConvertCoinToEvm(ctx context.Context, req *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
 }
+func (*UnimplementedMsgServer) ConvertEvmToCoin(ctx context.Context, req *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -953,6 +1087,24 @@ func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, req.(*MsgConvertEvmToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -973,6 +1125,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertCoinToEvm",
 			Handler:    _Msg_ConvertCoinToEvm_Handler,
 		},
+		{
+			MethodName: "ConvertEvmToCoin",
+			Handler:    _Msg_ConvertEvmToCoin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
@@ -1677,6 +1833,96 @@ func (m *MsgConvertCoinToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToBankAddr) > 0 {
+		i -= len(m.ToBankAddr)
+		copy(dAtA[i:], m.ToBankAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToBankAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1986,6 +2232,38 @@ func (m *MsgConvertCoinToEvmResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToBankAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertEvmToCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BankCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4161,6 +4439,271 @@ func (m *MsgConvertCoinToEvmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBankAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBankAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertEvmToCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -119,8 +119,13 @@ func NewTxDataFromTx(tx *gethcore.Transaction) (TxData, error) {
 		txData, err = NewDynamicFeeTx(tx)
 	case gethcore.AccessListTxType:
 		txData, err = newAccessListTx(tx)
-	default:
+	case gethcore.LegacyTxType:
 		txData, err = NewLegacyTx(tx)
+	default:
+		// Blob transactions (EIP-4844) are rejected, even with Cancun active.
+		return nil, sdkioerrors.Wrapf(
+			gethcore.ErrTxTypeNotSupported, "unsupported tx type %d", tx.Type(),
+		)
 	}
 	if err != nil {
 		return nil, err
@@ -2,10 +2,26 @@
 package evm
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"os"
+	"slices"
+	"sort"
+	"sync/atomic"
 
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	tracersnative "github.com/ethereum/go-ethereum/eth/tracers/native"
@@ -17,8 +33,22 @@ const (
 	TracerJSON       = "json"
 	TracerStruct     = "struct"
 	TracerMarkdown   = "markdown"
+	// TracerPrecompile is the name of the native tracer that records the
+	// Cosmos state changes made inside calls to Nibiru's custom precompiles.
+	// See [NewPrecompileTracer].
+	TracerPrecompile = "precompileTracer"
 )
 
+func init() {
+	tracers.DefaultDirectory.Register(
+		TracerPrecompile,
+		func(*tracers.Context, json.RawMessage, *params.ChainConfig) (*tracers.Tracer, error) {
+			return NewPrecompileTracer(), nil
+		},
+		false, // isJS
+	)
+}
+
 // NewTracer creates a new Logger tracer to collect execution traces from an
 // EVM transaction.
 func NewTracer(
@@ -34,7 +64,7 @@ func NewTracer(
 
 	switch tracer {
 	case TracerAccessList:
-		precompileAddrs := PRECOMPILE_ADDRS
+		precompileAddrs := ActivePrecompileAddrs(cfg)
 		return logger.NewAccessListTracer(
 			msg.AccessList,
 			msg.From,
@@ -47,6 +77,8 @@ func NewTracer(
 		return logger.NewMarkdownLogger(logCfg, os.Stdout).Hooks()
 	case TracerStruct:
 		return NewDefaultTracer().Hooks
+	case TracerPrecompile:
+		return NewPrecompileTracer().Hooks
 	default:
 		// The no-op tracer, `return NewNoOpTracer().Hooks` is meant for testing
 		// in geth, not production.
@@ -78,3 +110,344 @@ func NewNoOpTracer() (tracer *tracers.Tracer) {
 	tracer, _ = tracersnative.NewNoopTracer(nil, nil, nil)
 	return tracer
 }
+
+// tracedPrecompile is the name and the ABI of a custom precompile, which the
+// [TracerPrecompile] uses to decode its calls. The ABI is nil for precompiles
+// whose input is not ABI encoded, like P256Verify.
+type tracedPrecompile struct {
+	name string
+	abi  *gethabi.ABI
+}
+
+// customPrecompiles maps the addresses of Nibiru's custom precompiles to their
+// names and ABIs. It is filled by [RegisterTracedPrecompile] when the
+// precompile package is loaded.
+var customPrecompiles = map[gethcommon.Address]tracedPrecompile{}
+
+// RegisterTracedPrecompile registers the name and the ABI of a custom
+// precompile for the [TracerPrecompile]. The ABI may be nil. It panics if the
+// address is not a custom precompile of [PRECOMPILE_ADDRS].
+func RegisterTracedPrecompile(addr gethcommon.Address, name string, abi *gethabi.ABI) {
+	if !slices.Contains(customPrecompileAddrs, addr) {
+		panic(fmt.Errorf("%s is not the address of a custom precompile", addr.Hex()))
+	}
+	customPrecompiles[addr] = tracedPrecompile{name: name, abi: abi}
+}
+
+// PrecompileTraceResult is the result of the [TracerPrecompile]: one entry per
+// call frame into a custom precompile, in the order the calls were made.
+type PrecompileTraceResult struct {
+	Calls []*PrecompileCallTrace `json:"calls"`
+}
+
+// PrecompileCallTrace describes a single call into a custom precompile and the
+// Cosmos state changes it made.
+type PrecompileCallTrace struct {
+	// Precompile: Address of the precompiled contract.
+	Precompile gethcommon.Address `json:"precompile"`
+	// Name: Name of the precompile, like "FunToken".
+	Name string `json:"name"`
+	// Type: Type of the call frame, like "CALL" or "STATICCALL".
+	Type string `json:"type"`
+	// From: Caller of the precompile.
+	From    gethcommon.Address `json:"from"`
+	Depth   int                `json:"depth"`
+	Gas     hexutil.Uint64     `json:"gas"`
+	GasUsed hexutil.Uint64     `json:"gasUsed"`
+	Value   *hexutil.Big       `json:"value,omitempty"`
+	Input   hexutil.Bytes      `json:"input"`
+	Output  hexutil.Bytes      `json:"output,omitempty"`
+	Error   string             `json:"error,omitempty"`
+
+	// Method: Name of the ABI method called. Empty if the input could not be
+	// decoded.
+	Method string `json:"method,omitempty"`
+	// Args: Decoded ABI arguments of the method in order.
+	Args []PrecompileCallArg `json:"args,omitempty"`
+
+	// BankDeltas: Net change of the bank balances from the "coin_spent" and
+	// "coin_received" events emitted inside the call.
+	BankDeltas []BankBalanceDelta `json:"bankDeltas"`
+	// WasmMsgs: Wasm contract messages (execute, instantiate, migrate, sudo
+	// and reply) dispatched inside the call, including sub-messages.
+	WasmMsgs []WasmTracedMsg `json:"wasmMsgs"`
+	// Events: All SDK events emitted inside the call.
+	Events []sdk.StringEvent `json:"events"`
+
+	eventsStartIdx int
+}
+
+// PrecompileCallArg is a decoded ABI argument of a precompile call.
+type PrecompileCallArg struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// BankBalanceDelta is the net change in the bank balance of an account for a
+// single denomination.
+type BankBalanceDelta struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+	Amount  string `json:"amount"`
+}
+
+// WasmTracedMsg is a Wasm contract message dispatched inside a precompile call.
+type WasmTracedMsg struct {
+	Type     string `json:"type"`
+	Contract string `json:"contract"`
+	CodeID   string `json:"codeId,omitempty"`
+}
+
+// precompileTracer implements the [TracerPrecompile]. See [NewPrecompileTracer].
+type precompileTracer struct {
+	stateDB tracing.StateDB
+	// frames is the stack of open call frames. Frames that are not calls into
+	// a custom precompile are nil.
+	frames    []*PrecompileCallTrace
+	calls     []*PrecompileCallTrace
+	interrupt atomic.Bool
+	reason    error
+}
+
+// NewPrecompileTracer creates a native tracer that records, for each call
+// frame into a custom Nibiru precompile (FunToken, Wasm and Oracle), the
+// decoded method and arguments, the bank balance deltas, the Wasm messages
+// and the SDK events emitted while the precompile ran. This makes cross-VM
+// flows, like "sendToBank" or a Wasm "execute", visible in
+// "debug_traceTransaction", where other tracers only show an opaque call.
+//
+// The SDK events are read from the cached context that the
+// [statedb.StateDB] shares across all precompile calls of a transaction.
+func NewPrecompileTracer() *tracers.Tracer {
+	t := &precompileTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}
+}
+
+func (t *precompileTracer) OnTxStart(
+	vmCtx *tracing.VMContext, _ *gethcore.Transaction, _ gethcommon.Address,
+) {
+	t.stateDB = vmCtx.StateDB
+}
+
+func (t *precompileTracer) OnEnter(
+	depth int, typ byte, from, to gethcommon.Address, input []byte, gas uint64, value *big.Int,
+) {
+	if t.interrupt.Load() {
+		return
+	}
+	precompile, isCustomPrecompile := customPrecompiles[to]
+	if !isCustomPrecompile {
+		t.frames = append(t.frames, nil)
+		return
+	}
+
+	call := &PrecompileCallTrace{
+		Precompile:     to,
+		Name:           precompile.name,
+		Type:           vm.OpCode(typ).String(),
+		From:           from,
+		Depth:          depth,
+		Gas:            hexutil.Uint64(gas),
+		Input:          gethcommon.CopyBytes(input),
+		eventsStartIdx: len(t.events()),
+	}
+	if value != nil && value.Sign() != 0 {
+		call.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	call.Method, call.Args = decodePrecompileInput(precompile.abi, input)
+	t.frames = append(t.frames, call)
+	t.calls = append(t.calls, call)
+}
+
+func (t *precompileTracer) OnExit(
+	_ int, output []byte, gasUsed uint64, err error, _ bool,
+) {
+	if t.interrupt.Load() || len(t.frames) == 0 {
+		return
+	}
+	call := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if call == nil {
+		return
+	}
+
+	call.GasUsed = hexutil.Uint64(gasUsed)
+	call.Output = gethcommon.CopyBytes(output)
+	if err != nil {
+		call.Error = err.Error()
+	}
+
+	var emitted []sdk.Event
+	if events := t.events(); len(events) > call.eventsStartIdx {
+		emitted = events[call.eventsStartIdx:]
+	}
+	call.Events = make([]sdk.StringEvent, len(emitted))
+	for i, event := range emitted {
+		call.Events[i] = sdk.StringifyEvent(abci.Event(event))
+	}
+	call.BankDeltas = bankDeltasFromEvents(call.Events)
+	call.WasmMsgs = wasmMsgsFromEvents(call.Events)
+}
+
+func (t *precompileTracer) GetResult() (json.RawMessage, error) {
+	calls := t.calls
+	if calls == nil {
+		calls = []*PrecompileCallTrace{}
+	}
+	res, err := json.Marshal(PrecompileTraceResult{Calls: calls})
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+func (t *precompileTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// events returns the SDK events emitted by the precompile calls of the
+// current transaction so far.
+func (t *precompileTracer) events() []sdk.Event {
+	db, ok := t.stateDB.(interface{ GetCacheContext() *sdk.Context })
+	if !ok {
+		return nil
+	}
+	cacheCtx := db.GetCacheContext()
+	if cacheCtx == nil {
+		return nil
+	}
+	return cacheCtx.EventManager().Events()
+}
+
+// decodePrecompileInput decodes the ABI method and arguments of a precompile
+// call. It returns an empty method name if the input cannot be decoded.
+func decodePrecompileInput(
+	abi *gethabi.ABI, input []byte,
+) (method string, args []PrecompileCallArg) {
+	if abi == nil || len(input) < 4 {
+		return "", nil
+	}
+	abiMethod, err := abi.MethodById(input[:4])
+	if err != nil {
+		return "", nil
+	}
+	values, err := abiMethod.Inputs.Unpack(input[4:])
+	if err != nil {
+		return abiMethod.Name, nil
+	}
+	args = make([]PrecompileCallArg, len(values))
+	for i, value := range values {
+		arg := abiMethod.Inputs[i]
+		if bz, isBytes := value.([]byte); isBytes {
+			// Wasm messages are JSON, so show them as such when possible.
+			if json.Valid(bz) {
+				value = json.RawMessage(bz)
+			} else {
+				value = hexutil.Bytes(bz)
+			}
+		}
+		args[i] = PrecompileCallArg{Name: arg.Name, Type: arg.Type.String(), Value: value}
+	}
+	return abiMethod.Name, args
+}
+
+// bankDeltasFromEvents computes the net change of bank balances from the
+// "coin_spent" and "coin_received" events of the bank module.
+func bankDeltasFromEvents(events []sdk.StringEvent) []BankBalanceDelta {
+	deltas := make(map[string]map[string]sdkmath.Int)
+	addDelta := func(addr, amount string, sign int64) {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil || addr == "" {
+			return
+		}
+		if deltas[addr] == nil {
+			deltas[addr] = make(map[string]sdkmath.Int)
+		}
+		for _, coin := range coins {
+			prev, ok := deltas[addr][coin.Denom]
+			if !ok {
+				prev = sdkmath.ZeroInt()
+			}
+			deltas[addr][coin.Denom] = prev.Add(coin.Amount.MulRaw(sign))
+		}
+	}
+	for _, event := range events {
+		var addrKey string
+		var sign int64
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			addrKey, sign = banktypes.AttributeKeyReceiver, 1
+		default:
+			continue
+		}
+		var addr, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				addr = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		addDelta(addr, amount, sign)
+	}
+
+	out := []BankBalanceDelta{}
+	for addr, byDenom := range deltas {
+		for denom, amount := range byDenom {
+			if amount.IsZero() {
+				continue
+			}
+			out = append(out, BankBalanceDelta{
+				Address: addr, Denom: denom, Amount: amount.String(),
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Address != out[j].Address {
+			return out[i].Address < out[j].Address
+		}
+		return out[i].Denom < out[j].Denom
+	})
+	return out
+}
+
+// wasmMsgsFromEvents extracts the Wasm contract messages from the events of
+// the wasm module.
+func wasmMsgsFromEvents(events []sdk.StringEvent) []WasmTracedMsg {
+	msgs := []WasmTracedMsg{}
+	for _, event := range events {
+		switch event.Type {
+		case wasmtypes.EventTypeExecute,
+			wasmtypes.EventTypeInstantiate,
+			wasmtypes.EventTypeMigrate,
+			wasmtypes.EventTypeSudo,
+			wasmtypes.EventTypeReply:
+		default:
+			continue
+		}
+		msg := WasmTracedMsg{Type: event.Type}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case wasmtypes.AttributeKeyContractAddr:
+				msg.Contract = attr.Value
+			case wasmtypes.AttributeKeyCodeID:
+				msg.CodeID = attr.Value
+			}
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}